@@ -0,0 +1,101 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package allinone hosts a gnetway and a session in one process, they call each other
+// directly instead of over grpc, e.g. for edge deployments and integration tests.
+package allinone
+
+import (
+	"flag"
+	"fmt"
+
+	gnetway_helper "github.com/teamgram/teamgram-server/v2/app/interface/gnetway"
+	session_helper "github.com/teamgram/teamgram-server/v2/app/interface/session"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var configFile = flag.String("f", "etc/allinone.yaml", "the config file")
+
+// Config shares one file, each service keeps its own section.
+type Config struct {
+	Gnetway gnetway_helper.Config
+	Session session_helper.Config
+}
+
+// session is the Session section, it advertises the listeners of the Gnetway
+// unless it lists its own.
+func (c Config) session() session_helper.Config {
+	session := c.Session
+	if len(session.Help.Listeners) == 0 && c.Gnetway.Gnetway != nil {
+		session.Help.Listeners = c.Gnetway.Gnetway.Server
+	}
+	return session
+}
+
+func (c Config) Validate() error {
+	if err := c.Gnetway.Validate(); err != nil {
+		return fmt.Errorf("Gnetway: %v", err)
+	}
+	if err := c.session().Validate(); err != nil {
+		return fmt.Errorf("Session: %v", err)
+	}
+
+	return nil
+}
+
+type Server struct {
+	gnetway *gnetway_helper.Server
+	session *session_helper.Server
+}
+
+func New() *Server {
+	return new(Server)
+}
+
+func (s *Server) Initialize() error {
+	var c Config
+	conf2.MustLoad(*configFile, &c)
+
+	logx.Infov(c)
+
+	s.session = session_helper.NewInProcess(c.session())
+	if err := s.session.Initialize(); err != nil {
+		return err
+	}
+
+	s.gnetway = gnetway_helper.NewInProcess(c.Gnetway, s.session.SessionClient())
+	if err := s.gnetway.Initialize(); err != nil {
+		return err
+	}
+	s.session.SetGatewayClient(s.gnetway.GatewayId(), s.gnetway.GatewayClient())
+
+	return nil
+}
+
+func (s *Server) RunLoop() {
+	s.session.RunLoop()
+	s.gnetway.RunLoop()
+}
+
+func (s *Server) Destroy() {
+	// no new connections first, then the session hands off what it has
+	s.gnetway.Destroy()
+	s.session.Destroy()
+}
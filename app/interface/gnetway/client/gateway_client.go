@@ -23,6 +23,11 @@ var _ *mtproto.Bool
 
 type GatewayClient interface {
 	GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGatewaySendDataToGateway) (*mtproto.Bool, error)
+	GatewaySendDataToGatewayWithReceipt(ctx context.Context, in *gateway.TLGatewaySendDataToGatewayWithReceipt) (*gateway.Vector_DeliveryReceipt, error)
+	GatewaySendBatchDataToGateway(ctx context.Context, in *gateway.TLGatewaySendBatchDataToGateway) (*gateway.Vector_GatewayDeliveryResult, error)
+	GatewayBroadcastDataToGateway(ctx context.Context, in *gateway.TLGatewayBroadcastDataToGateway) (*gateway.Vector_GatewayDeliveryResult, error)
+	GatewayInvalidateAuthKey(ctx context.Context, in *gateway.TLGatewayInvalidateAuthKey) (*mtproto.Bool, error)
+	GatewayDrainGateway(ctx context.Context, in *gateway.TLGatewayDrainGateway) (*mtproto.Bool, error)
 }
 
 type defaultGatewayClient struct {
@@ -41,3 +46,38 @@ func (m *defaultGatewayClient) GatewaySendDataToGateway(ctx context.Context, in
 	client := gateway.NewRPCGatewayClient(m.cli.Conn())
 	return client.GatewaySendDataToGateway(ctx, in)
 }
+
+// GatewaySendDataToGatewayWithReceipt
+// gateway.sendDataToGatewayWithReceipt auth_key_id:long session_id:long payload:bytes timeout:int = Vector<DeliveryReceipt>;
+func (m *defaultGatewayClient) GatewaySendDataToGatewayWithReceipt(ctx context.Context, in *gateway.TLGatewaySendDataToGatewayWithReceipt) (*gateway.Vector_DeliveryReceipt, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewaySendDataToGatewayWithReceipt(ctx, in)
+}
+
+// GatewaySendBatchDataToGateway
+// gateway.sendBatchDataToGateway items:Vector<GatewayData> timeout:int = Vector<GatewayDeliveryResult>;
+func (m *defaultGatewayClient) GatewaySendBatchDataToGateway(ctx context.Context, in *gateway.TLGatewaySendBatchDataToGateway) (*gateway.Vector_GatewayDeliveryResult, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewaySendBatchDataToGateway(ctx, in)
+}
+
+// GatewayBroadcastDataToGateway
+// gateway.broadcastDataToGateway perm_auth_key_id:long body:bytes timeout:int = Vector<GatewayDeliveryResult>;
+func (m *defaultGatewayClient) GatewayBroadcastDataToGateway(ctx context.Context, in *gateway.TLGatewayBroadcastDataToGateway) (*gateway.Vector_GatewayDeliveryResult, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewayBroadcastDataToGateway(ctx, in)
+}
+
+// GatewayInvalidateAuthKey
+// gateway.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (m *defaultGatewayClient) GatewayInvalidateAuthKey(ctx context.Context, in *gateway.TLGatewayInvalidateAuthKey) (*mtproto.Bool, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewayInvalidateAuthKey(ctx, in)
+}
+
+// GatewayDrainGateway
+// gateway.drainGateway window:int = Bool;
+func (m *defaultGatewayClient) GatewayDrainGateway(ctx context.Context, in *gateway.TLGatewayDrainGateway) (*mtproto.Bool, error) {
+	client := gateway.NewRPCGatewayClient(m.cli.Conn())
+	return client.GatewayDrainGateway(ctx, in)
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package gateway_client
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+)
+
+// gatewayStreamClient sends to a gnetway over the stream the gnetway opened to us,
+// the calls waiting for a reply of the gnetway go to the unary client.
+type gatewayStreamClient struct {
+	GatewayClient
+	link *streamlink.Link
+}
+
+// NewGatewayStreamClient sends sendDataToGateway and invalidateAuthKey over link,
+// the other methods over cli.
+func NewGatewayStreamClient(link *streamlink.Link, cli GatewayClient) GatewayClient {
+	return &gatewayStreamClient{
+		GatewayClient: cli,
+		link:          link,
+	}
+}
+
+// GatewaySendDataToGateway
+// gateway.sendDataToGateway auth_key_id:long session_id:long payload:bytes = Bool;
+func (m *gatewayStreamClient) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGatewaySendDataToGateway) (*mtproto.Bool, error) {
+	err := m.link.Push(ctx, &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_GatewayData{
+			GatewayData: &session.SessionStreamGatewayData{
+				AuthKeyId: in.AuthKeyId,
+				SessionId: in.SessionId,
+				Payload:   in.Payload,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
+
+// GatewayInvalidateAuthKey
+// gateway.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (m *gatewayStreamClient) GatewayInvalidateAuthKey(ctx context.Context, in *gateway.TLGatewayInvalidateAuthKey) (*mtproto.Bool, error) {
+	err := m.link.Push(ctx, &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_InvalidateAuthKey{
+			InvalidateAuthKey: &session.SessionStreamInvalidateAuthKey{
+				AuthKeyId: in.AuthKeyId,
+				Destroyed: in.Destroyed,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// gnetway-kitex is the gnetway serving gnetway.RPCgnetway over kitex on Kitex.ListenOn
+// too, next to gateway.RPCGateway over grpc.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/teamgram/marmota/pkg/commands"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/tg/service"
+)
+
+var (
+	configFile  = flag.String("f", "etc/gnetway.yaml", "the config file")
+	checkConfig = flag.Bool("check-config", false, "validate the config file, print the listeners and key fingerprints, then exit")
+)
+
+func main() {
+	flag.Parse()
+	if *checkConfig {
+		if err := gnetway_helper.CheckConfig(*configFile, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	commands.Run(gnetway_helper.New(configFile).WithRunner(service.NewRunner))
+}
@@ -19,10 +19,28 @@
 package main
 
 import (
+	"flag"
+	"fmt"
+	"os"
+
 	"github.com/teamgram/marmota/pkg/commands"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway"
 )
 
+var (
+	configFile  = flag.String("f", "etc/gnetway.yaml", "the config file")
+	checkConfig = flag.Bool("check-config", false, "validate the config file, print the listeners and key fingerprints, then exit")
+)
+
 func main() {
-	commands.Run(new(gnetway_helper.Server))
+	flag.Parse()
+	if *checkConfig {
+		if err := gnetway_helper.CheckConfig(*configFile, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
+	commands.Run(gnetway_helper.New(configFile))
 }
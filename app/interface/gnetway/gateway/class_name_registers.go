@@ -11,18 +11,66 @@
 package gateway
 
 const (
-	Predicate_gateway_sendDataToGateway = "gateway_sendDataToGateway"
+	Predicate_deliveryReceipt                      = "deliveryReceipt"
+	Predicate_gatewayData                          = "gatewayData"
+	Predicate_gatewayDeliveryResult                = "gatewayDeliveryResult"
+	Predicate_gateway_sendDataToGateway            = "gateway_sendDataToGateway"
+	Predicate_gateway_invalidateAuthKey            = "gateway_invalidateAuthKey"
+	Predicate_gateway_sendDataToGatewayWithReceipt = "gateway_sendDataToGatewayWithReceipt"
+	Predicate_gateway_sendBatchDataToGateway       = "gateway_sendBatchDataToGateway"
+	Predicate_gateway_broadcastDataToGateway       = "gateway_broadcastDataToGateway"
+	Predicate_gateway_drainGateway                 = "gateway_drainGateway"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
+	Predicate_deliveryReceipt: {
+		0: 184844578, // 0xb048122
+
+	},
+	Predicate_gatewayData: {
+		0: -417417636, // 0xe71eb65c
+
+	},
+	Predicate_gatewayDeliveryResult: {
+		0: 1918684609, // 0x725ccdc1
+
+	},
 	Predicate_gateway_sendDataToGateway: {
 		0: 645953552, // 0x26807810
 
 	},
+	Predicate_gateway_invalidateAuthKey: {
+		0: 1012084635, // 0x3c532f9b
+
+	},
+	Predicate_gateway_sendDataToGatewayWithReceipt: {
+		0: -2009856582, // 0x883405ba
+
+	},
+	Predicate_gateway_sendBatchDataToGateway: {
+		0: 407167555, // 0x1844e243
+
+	},
+	Predicate_gateway_broadcastDataToGateway: {
+		0: 1498017530, // 0x5949eefa
+
+	},
+	Predicate_gateway_drainGateway: {
+		0: -341630298, // 0xeba322a6
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
-	645953552: Predicate_gateway_sendDataToGateway, // 0x26807810
+	184844578:   Predicate_deliveryReceipt,                      // 0xb048122
+	645953552:   Predicate_gateway_sendDataToGateway,            // 0x26807810
+	1012084635:  Predicate_gateway_invalidateAuthKey,            // 0x3c532f9b
+	-2009856582: Predicate_gateway_sendDataToGatewayWithReceipt, // 0x883405ba
+	-417417636:  Predicate_gatewayData,                          // 0xe71eb65c
+	1918684609:  Predicate_gatewayDeliveryResult,                // 0x725ccdc1
+	407167555:   Predicate_gateway_sendBatchDataToGateway,       // 0x1844e243
+	1498017530:  Predicate_gateway_broadcastDataToGateway,       // 0x5949eefa
+	-341630298:  Predicate_gateway_drainGateway,                 // 0xeba322a6
 
 }
 
@@ -29,6 +29,22 @@ var _ fmt.Stringer
 
 var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 	// Constructor
+	184844578: func() mtproto.TLObject { // 0xb048122
+		o := MakeTLDeliveryReceipt(nil)
+		o.Data2.Constructor = 184844578
+		return o
+	},
+
+	-417417636: func() mtproto.TLObject { // 0xe71eb65c
+		o := MakeTLGatewayData(nil)
+		o.Data2.Constructor = -417417636
+		return o
+	},
+	1918684609: func() mtproto.TLObject { // 0x725ccdc1
+		o := MakeTLGatewayDeliveryResult(nil)
+		o.Data2.Constructor = 1918684609
+		return o
+	},
 
 	// Method
 	645953552: func() mtproto.TLObject { // 0x26807810
@@ -36,6 +52,31 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 645953552,
 		}
 	},
+	1012084635: func() mtproto.TLObject { // 0x3c532f9b
+		return &TLGatewayInvalidateAuthKey{
+			Constructor: 1012084635,
+		}
+	},
+	-2009856582: func() mtproto.TLObject { // 0x883405ba
+		return &TLGatewaySendDataToGatewayWithReceipt{
+			Constructor: -2009856582,
+		}
+	},
+	407167555: func() mtproto.TLObject { // 0x1844e243
+		return &TLGatewaySendBatchDataToGateway{
+			Constructor: 407167555,
+		}
+	},
+	1498017530: func() mtproto.TLObject { // 0x5949eefa
+		return &TLGatewayBroadcastDataToGateway{
+			Constructor: 1498017530,
+		}
+	},
+	-341630298: func() mtproto.TLObject { // 0xeba322a6
+		return &TLGatewayDrainGateway{
+			Constructor: -341630298,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -53,6 +94,397 @@ func CheckClassID(classId int32) (ok bool) {
 
 //----------------------------------------------------------------------------------------------------------------
 
+//----------------------------------------------------------------------------------------------------------------
+
+///////////////////////////////////////////////////////////////////////////////
+// DeliveryReceipt <--
+//  + TL_DeliveryReceipt
+//
+
+func (m *DeliveryReceipt) Encode(x *mtproto.EncodeBuf, layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	switch predicateName {
+	case Predicate_deliveryReceipt:
+		t := m.To_DeliveryReceipt()
+		t.Encode(x, layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *DeliveryReceipt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *DeliveryReceipt) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xb048122:
+		m2 := MakeTLDeliveryReceipt(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+// To_DeliveryReceipt
+func (m *DeliveryReceipt) To_DeliveryReceipt() *TLDeliveryReceipt {
+	m.PredicateName = Predicate_deliveryReceipt
+	return &TLDeliveryReceipt{
+		Data2: m,
+	}
+}
+
+// MakeTLDeliveryReceipt
+func MakeTLDeliveryReceipt(data2 *DeliveryReceipt) *TLDeliveryReceipt {
+	if data2 == nil {
+		return &TLDeliveryReceipt{Data2: &DeliveryReceipt{
+			PredicateName: Predicate_deliveryReceipt,
+		}}
+	} else {
+		data2.PredicateName = Predicate_deliveryReceipt
+		return &TLDeliveryReceipt{Data2: data2}
+	}
+}
+
+func (m *TLDeliveryReceipt) To_DeliveryReceipt() *DeliveryReceipt {
+	m.Data2.PredicateName = Predicate_deliveryReceipt
+	return m.Data2
+}
+
+func (m *TLDeliveryReceipt) SetConnId(v int64) { m.Data2.ConnId = v }
+func (m *TLDeliveryReceipt) GetConnId() int64  { return m.Data2.ConnId }
+
+func (m *TLDeliveryReceipt) SetStatus(v int32) { m.Data2.Status = v }
+func (m *TLDeliveryReceipt) GetStatus() int32  { return m.Data2.Status }
+
+func (m *TLDeliveryReceipt) GetPredicateName() string {
+	return Predicate_deliveryReceipt
+}
+
+func (m *TLDeliveryReceipt) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0xb048122: func() error {
+			x.UInt(0xb048122)
+
+			x.Long(m.GetConnId())
+			x.Int(m.GetStatus())
+			return nil
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_deliveryReceipt, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_deliveryReceipt, layer)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *TLDeliveryReceipt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLDeliveryReceipt) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xb048122: func() error {
+			m.SetConnId(dBuf.Long())
+			m.SetStatus(dBuf.Int())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GatewayData <--
+//  + TL_GatewayData
+//
+
+func (m *GatewayData) Encode(x *mtproto.EncodeBuf, layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	switch predicateName {
+	case Predicate_gatewayData:
+		t := m.To_GatewayData()
+		t.Encode(x, layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *GatewayData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *GatewayData) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0xe71eb65c:
+		m2 := MakeTLGatewayData(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+// To_GatewayData
+func (m *GatewayData) To_GatewayData() *TLGatewayData {
+	m.PredicateName = Predicate_gatewayData
+	return &TLGatewayData{
+		Data2: m,
+	}
+}
+
+// MakeTLGatewayData
+func MakeTLGatewayData(data2 *GatewayData) *TLGatewayData {
+	if data2 == nil {
+		return &TLGatewayData{Data2: &GatewayData{
+			PredicateName: Predicate_gatewayData,
+		}}
+	} else {
+		data2.PredicateName = Predicate_gatewayData
+		return &TLGatewayData{Data2: data2}
+	}
+}
+
+func (m *TLGatewayData) To_GatewayData() *GatewayData {
+	m.Data2.PredicateName = Predicate_gatewayData
+	return m.Data2
+}
+
+func (m *TLGatewayData) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
+func (m *TLGatewayData) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
+
+func (m *TLGatewayData) SetSessionId(v int64) { m.Data2.SessionId = v }
+func (m *TLGatewayData) GetSessionId() int64  { return m.Data2.SessionId }
+
+func (m *TLGatewayData) SetPayload(v []byte) { m.Data2.Payload = v }
+func (m *TLGatewayData) GetPayload() []byte  { return m.Data2.Payload }
+
+func (m *TLGatewayData) GetPredicateName() string {
+	return Predicate_gatewayData
+}
+
+func (m *TLGatewayData) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0xe71eb65c: func() error {
+			x.UInt(0xe71eb65c)
+
+			x.Long(m.GetAuthKeyId())
+			x.Long(m.GetSessionId())
+			x.StringBytes(m.GetPayload())
+			return nil
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_gatewayData, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_gatewayData, layer)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *TLGatewayData) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayData) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0xe71eb65c: func() error {
+			m.SetAuthKeyId(dBuf.Long())
+			m.SetSessionId(dBuf.Long())
+			m.SetPayload(dBuf.StringBytes())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
+///////////////////////////////////////////////////////////////////////////////
+// GatewayDeliveryResult <--
+//  + TL_GatewayDeliveryResult
+//
+
+func (m *GatewayDeliveryResult) Encode(x *mtproto.EncodeBuf, layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	switch predicateName {
+	case Predicate_gatewayDeliveryResult:
+		t := m.To_GatewayDeliveryResult()
+		t.Encode(x, layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *GatewayDeliveryResult) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *GatewayDeliveryResult) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x725ccdc1:
+		m2 := MakeTLGatewayDeliveryResult(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+// To_GatewayDeliveryResult
+func (m *GatewayDeliveryResult) To_GatewayDeliveryResult() *TLGatewayDeliveryResult {
+	m.PredicateName = Predicate_gatewayDeliveryResult
+	return &TLGatewayDeliveryResult{
+		Data2: m,
+	}
+}
+
+// MakeTLGatewayDeliveryResult
+func MakeTLGatewayDeliveryResult(data2 *GatewayDeliveryResult) *TLGatewayDeliveryResult {
+	if data2 == nil {
+		return &TLGatewayDeliveryResult{Data2: &GatewayDeliveryResult{
+			PredicateName: Predicate_gatewayDeliveryResult,
+		}}
+	} else {
+		data2.PredicateName = Predicate_gatewayDeliveryResult
+		return &TLGatewayDeliveryResult{Data2: data2}
+	}
+}
+
+func (m *TLGatewayDeliveryResult) To_GatewayDeliveryResult() *GatewayDeliveryResult {
+	m.Data2.PredicateName = Predicate_gatewayDeliveryResult
+	return m.Data2
+}
+
+func (m *TLGatewayDeliveryResult) SetAuthKeyId(v int64) { m.Data2.AuthKeyId = v }
+func (m *TLGatewayDeliveryResult) GetAuthKeyId() int64  { return m.Data2.AuthKeyId }
+
+func (m *TLGatewayDeliveryResult) SetSessionId(v int64) { m.Data2.SessionId = v }
+func (m *TLGatewayDeliveryResult) GetSessionId() int64  { return m.Data2.SessionId }
+
+func (m *TLGatewayDeliveryResult) SetReceipts(v []*DeliveryReceipt) { m.Data2.Receipts = v }
+func (m *TLGatewayDeliveryResult) GetReceipts() []*DeliveryReceipt  { return m.Data2.Receipts }
+
+func (m *TLGatewayDeliveryResult) GetPredicateName() string {
+	return Predicate_gatewayDeliveryResult
+}
+
+func (m *TLGatewayDeliveryResult) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0x725ccdc1: func() error {
+			x.UInt(0x725ccdc1)
+
+			x.Long(m.GetAuthKeyId())
+			x.Long(m.GetSessionId())
+
+			x.Int(int32(mtproto.CRC32_vector))
+			x.Int(int32(len(m.GetReceipts())))
+			for _, v := range m.GetReceipts() {
+				v.Encode(x, layer)
+			}
+			return nil
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_gatewayDeliveryResult, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_gatewayDeliveryResult, layer)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *TLGatewayDeliveryResult) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayDeliveryResult) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x725ccdc1: func() error {
+			m.SetAuthKeyId(dBuf.Long())
+			m.SetSessionId(dBuf.Long())
+			c0 := dBuf.Int()
+			if c0 != int32(mtproto.CRC32_vector) {
+				// dBuf.err = fmt.Errorf("invalid CRC32_vector, c%d: %d", 0, c0)
+				return dBuf.GetError()
+			}
+			l0 := dBuf.Int()
+			v0 := make([]*DeliveryReceipt, l0)
+			for i := int32(0); i < l0; i++ {
+				v0[i] = &DeliveryReceipt{}
+				v0[i].Decode(dBuf)
+			}
+			m.SetReceipts(v0)
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
 //----------------------------------------------------------------------------------------------------------------
 // TLGatewaySendDataToGateway
 ///////////////////////////////////////////////////////////////////////////////
@@ -95,3 +527,296 @@ func (m *TLGatewaySendDataToGateway) Decode(dBuf *mtproto.DecodeBuf) error {
 	}
 	return dBuf.GetError()
 }
+
+// TLGatewayInvalidateAuthKey
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLGatewayInvalidateAuthKey) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0x3c532f9b:
+		x.UInt(0x3c532f9b)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetDestroyed() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLGatewayInvalidateAuthKey) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayInvalidateAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x3c532f9b:
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.AuthKeyId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Destroyed = true
+		}
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+// TLGatewaySendDataToGatewayWithReceipt
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLGatewaySendDataToGatewayWithReceipt) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0x883405ba:
+		x.UInt(0x883405ba)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+		x.Long(m.GetSessionId())
+		x.StringBytes(m.GetPayload())
+		x.Int(m.GetTimeout())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLGatewaySendDataToGatewayWithReceipt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewaySendDataToGatewayWithReceipt) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x883405ba:
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		m.SessionId = dBuf.Long()
+		m.Payload = dBuf.StringBytes()
+		m.Timeout = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLGatewaySendBatchDataToGateway
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLGatewaySendBatchDataToGateway) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0x1844e243:
+		x.UInt(0x1844e243)
+
+		// no flags
+
+		x.Int(int32(mtproto.CRC32_vector))
+		x.Int(int32(len(m.GetItems())))
+		for _, v := range m.GetItems() {
+			v.Encode(x, layer)
+		}
+		x.Int(m.GetTimeout())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLGatewaySendBatchDataToGateway) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewaySendBatchDataToGateway) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x1844e243:
+
+		// not has flags
+
+		c1 := dBuf.Int()
+		if c1 != int32(mtproto.CRC32_vector) {
+			// dBuf.err = fmt.Errorf("invalid CRC32_vector, c%d: %d", 1, c1)
+			return dBuf.GetError()
+		}
+		l1 := dBuf.Int()
+		v1 := make([]*GatewayData, l1)
+		for i := int32(0); i < l1; i++ {
+			v1[i] = &GatewayData{}
+			v1[i].Decode(dBuf)
+		}
+		m.Items = v1
+		m.Timeout = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// TLGatewayBroadcastDataToGateway
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLGatewayBroadcastDataToGateway) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0x5949eefa:
+		x.UInt(0x5949eefa)
+
+		// no flags
+
+		x.Long(m.GetPermAuthKeyId())
+		x.StringBytes(m.GetBody())
+		x.Int(m.GetTimeout())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLGatewayBroadcastDataToGateway) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayBroadcastDataToGateway) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x5949eefa:
+
+		// not has flags
+
+		m.PermAuthKeyId = dBuf.Long()
+		m.Body = dBuf.StringBytes()
+		m.Timeout = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_DeliveryReceipt
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *Vector_DeliveryReceipt) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		v.Encode(x, layer)
+	}
+
+	return nil
+}
+
+func (m *Vector_DeliveryReceipt) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*DeliveryReceipt, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(DeliveryReceipt)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_DeliveryReceipt) CalcByteSize(layer int32) int {
+	return 0
+}
+
+//----------------------------------------------------------------------------------------------------------------
+// Vector_GatewayDeliveryResult
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *Vector_GatewayDeliveryResult) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	x.Int(int32(mtproto.CRC32_vector))
+	x.Int(int32(len(m.Datas)))
+	for _, v := range m.Datas {
+		v.Encode(x, layer)
+	}
+
+	return nil
+}
+
+func (m *Vector_GatewayDeliveryResult) Decode(dBuf *mtproto.DecodeBuf) error {
+	dBuf.Int() // TODO(@benqi): Check crc32 invalid
+	l1 := dBuf.Int()
+	m.Datas = make([]*GatewayDeliveryResult, l1)
+	for i := int32(0); i < l1; i++ {
+		m.Datas[i] = new(GatewayDeliveryResult)
+		(*m.Datas[i]).Decode(dBuf)
+	}
+
+	return dBuf.GetError()
+}
+
+func (m *Vector_GatewayDeliveryResult) CalcByteSize(layer int32) int {
+	return 0
+}
+
+// TLGatewayDrainGateway
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLGatewayDrainGateway) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0xeba322a6:
+		x.UInt(0xeba322a6)
+
+		// no flags
+
+		x.Int(m.GetWindow())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLGatewayDrainGateway) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLGatewayDrainGateway) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xeba322a6:
+
+		// not has flags
+
+		m.Window = dBuf.Int()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package gateway
+
+// The status of a DeliveryReceipt.
+const (
+	// DeliveryWritten the payload was handed to the connection.
+	DeliveryWritten int32 = 1
+	// DeliveryNoConnection the session has no connection, or it closed before the write.
+	DeliveryNoConnection int32 = 2
+	// DeliveryWriteError writing to the connection failed.
+	DeliveryWriteError int32 = 3
+	// DeliveryKeyMismatch the connection uses another auth key by now.
+	DeliveryKeyMismatch int32 = 4
+	// DeliveryTimeout the write did not finish within the timeout of the request.
+	DeliveryTimeout int32 = 5
+)
+
+// DefaultDeliveryTimeout is used when gateway.sendDataToGatewayWithReceipt carries no timeout, in ms.
+const DefaultDeliveryTimeout int32 = 5000
+
+// NewDeliveryReceipt
+func NewDeliveryReceipt(connId int64, status int32) *DeliveryReceipt {
+	return MakeTLDeliveryReceipt(&DeliveryReceipt{
+		Constructor: CRC32_deliveryReceipt,
+		ConnId:      connId,
+		Status:      status,
+	}).To_DeliveryReceipt()
+}
+
+// Delivered reports whether any connection got the payload.
+func Delivered(receipts []*DeliveryReceipt) bool {
+	for _, v := range receipts {
+		if v.GetStatus() == DeliveryWritten {
+			return true
+		}
+	}
+
+	return false
+}
@@ -11,6 +11,14 @@
 package gateway
 
 const (
-	CRC32_UNKNOWN                   TLConstructor = 0
-	CRC32_gateway_sendDataToGateway TLConstructor = 645953552 // 0x26807810
+	CRC32_UNKNOWN                              TLConstructor = 0
+	CRC32_deliveryReceipt                      TLConstructor = 184844578   // 0xb048122
+	CRC32_gateway_sendDataToGateway            TLConstructor = 645953552   // 0x26807810
+	CRC32_gateway_invalidateAuthKey            TLConstructor = 1012084635  // 0x3c532f9b
+	CRC32_gateway_sendDataToGatewayWithReceipt TLConstructor = -2009856582 // 0x883405ba
+	CRC32_gatewayData                          TLConstructor = -417417636  // 0xe71eb65c
+	CRC32_gatewayDeliveryResult                TLConstructor = 1918684609  // 0x725ccdc1
+	CRC32_gateway_sendBatchDataToGateway       TLConstructor = 407167555   // 0x1844e243
+	CRC32_gateway_broadcastDataToGateway       TLConstructor = 1498017530  // 0x5949eefa
+	CRC32_gateway_drainGateway                 TLConstructor = -341630298  // 0xeba322a6
 )
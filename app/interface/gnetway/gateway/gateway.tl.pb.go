@@ -34,19 +34,43 @@ const (
 type TLConstructor int32
 
 const (
-	TLConstructor_CRC32_UNKNOWN                   TLConstructor = 0
-	TLConstructor_CRC32_gateway_sendDataToGateway TLConstructor = 645953552
+	TLConstructor_CRC32_UNKNOWN                              TLConstructor = 0
+	TLConstructor_CRC32_deliveryReceipt                      TLConstructor = 184844578
+	TLConstructor_CRC32_gatewayData                          TLConstructor = -417417636
+	TLConstructor_CRC32_gatewayDeliveryResult                TLConstructor = 1918684609
+	TLConstructor_CRC32_gateway_sendDataToGateway            TLConstructor = 645953552
+	TLConstructor_CRC32_gateway_sendDataToGatewayWithReceipt TLConstructor = -2009856582
+	TLConstructor_CRC32_gateway_sendBatchDataToGateway       TLConstructor = 407167555
+	TLConstructor_CRC32_gateway_broadcastDataToGateway       TLConstructor = 1498017530
+	TLConstructor_CRC32_gateway_invalidateAuthKey            TLConstructor = 1012084635
+	TLConstructor_CRC32_gateway_drainGateway                 TLConstructor = -341630298
 )
 
 // Enum value maps for TLConstructor.
 var (
 	TLConstructor_name = map[int32]string{
-		0:         "CRC32_UNKNOWN",
-		645953552: "CRC32_gateway_sendDataToGateway",
+		0:           "CRC32_UNKNOWN",
+		184844578:   "CRC32_deliveryReceipt",
+		-417417636:  "CRC32_gatewayData",
+		1918684609:  "CRC32_gatewayDeliveryResult",
+		645953552:   "CRC32_gateway_sendDataToGateway",
+		-2009856582: "CRC32_gateway_sendDataToGatewayWithReceipt",
+		407167555:   "CRC32_gateway_sendBatchDataToGateway",
+		1498017530:  "CRC32_gateway_broadcastDataToGateway",
+		1012084635:  "CRC32_gateway_invalidateAuthKey",
+		-341630298:  "CRC32_gateway_drainGateway",
 	}
 	TLConstructor_value = map[string]int32{
-		"CRC32_UNKNOWN":                   0,
-		"CRC32_gateway_sendDataToGateway": 645953552,
+		"CRC32_UNKNOWN":                              0,
+		"CRC32_deliveryReceipt":                      184844578,
+		"CRC32_gatewayData":                          -417417636,
+		"CRC32_gatewayDeliveryResult":                1918684609,
+		"CRC32_gateway_sendDataToGateway":            645953552,
+		"CRC32_gateway_sendDataToGatewayWithReceipt": -2009856582,
+		"CRC32_gateway_sendBatchDataToGateway":       407167555,
+		"CRC32_gateway_broadcastDataToGateway":       1498017530,
+		"CRC32_gateway_invalidateAuthKey":            1012084635,
+		"CRC32_gateway_drainGateway":                 -341630298,
 	}
 )
 
@@ -77,6 +101,382 @@ func (TLConstructor) EnumDescriptor() ([]byte, []int) {
 	return file_gateway_tl_proto_rawDescGZIP(), []int{0}
 }
 
+// DeliveryReceipt <--
+//   - TL_deliveryReceipt
+type DeliveryReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PredicateName string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor   TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	ConnId        int64         `protobuf:"varint,3,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	Status        int32         `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DeliveryReceipt) Reset() {
+	*x = DeliveryReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryReceipt) ProtoMessage() {}
+
+func (x *DeliveryReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryReceipt.ProtoReflect.Descriptor instead.
+func (*DeliveryReceipt) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeliveryReceipt) GetPredicateName() string {
+	if x != nil {
+		return x.PredicateName
+	}
+	return ""
+}
+
+func (x *DeliveryReceipt) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *DeliveryReceipt) GetConnId() int64 {
+	if x != nil {
+		return x.ConnId
+	}
+	return 0
+}
+
+func (x *DeliveryReceipt) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type TLDeliveryReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data2 *DeliveryReceipt `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+}
+
+func (x *TLDeliveryReceipt) Reset() {
+	*x = TLDeliveryReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLDeliveryReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLDeliveryReceipt) ProtoMessage() {}
+
+func (x *TLDeliveryReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLDeliveryReceipt.ProtoReflect.Descriptor instead.
+func (*TLDeliveryReceipt) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TLDeliveryReceipt) GetData2() *DeliveryReceipt {
+	if x != nil {
+		return x.Data2
+	}
+	return nil
+}
+
+// GatewayData <--
+//   - TL_gatewayData
+type GatewayData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PredicateName string        `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor   TLConstructor `protobuf:"varint,2,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId     int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	SessionId     int64         `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Payload       []byte        `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *GatewayData) Reset() {
+	*x = GatewayData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayData) ProtoMessage() {}
+
+func (x *GatewayData) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayData.ProtoReflect.Descriptor instead.
+func (*GatewayData) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatewayData) GetPredicateName() string {
+	if x != nil {
+		return x.PredicateName
+	}
+	return ""
+}
+
+func (x *GatewayData) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *GatewayData) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *GatewayData) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *GatewayData) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type TLGatewayData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data2 *GatewayData `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+}
+
+func (x *TLGatewayData) Reset() {
+	*x = TLGatewayData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewayData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewayData) ProtoMessage() {}
+
+func (x *TLGatewayData) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewayData.ProtoReflect.Descriptor instead.
+func (*TLGatewayData) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TLGatewayData) GetData2() *GatewayData {
+	if x != nil {
+		return x.Data2
+	}
+	return nil
+}
+
+// GatewayDeliveryResult <--
+//   - TL_gatewayDeliveryResult
+type GatewayDeliveryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PredicateName string             `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor   TLConstructor      `protobuf:"varint,2,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId     int64              `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	SessionId     int64              `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Receipts      []*DeliveryReceipt `protobuf:"bytes,5,rep,name=receipts,proto3" json:"receipts,omitempty"`
+}
+
+func (x *GatewayDeliveryResult) Reset() {
+	*x = GatewayDeliveryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayDeliveryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayDeliveryResult) ProtoMessage() {}
+
+func (x *GatewayDeliveryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayDeliveryResult.ProtoReflect.Descriptor instead.
+func (*GatewayDeliveryResult) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GatewayDeliveryResult) GetPredicateName() string {
+	if x != nil {
+		return x.PredicateName
+	}
+	return ""
+}
+
+func (x *GatewayDeliveryResult) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *GatewayDeliveryResult) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *GatewayDeliveryResult) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *GatewayDeliveryResult) GetReceipts() []*DeliveryReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+type TLGatewayDeliveryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data2 *GatewayDeliveryResult `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+}
+
+func (x *TLGatewayDeliveryResult) Reset() {
+	*x = TLGatewayDeliveryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewayDeliveryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewayDeliveryResult) ProtoMessage() {}
+
+func (x *TLGatewayDeliveryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewayDeliveryResult.ProtoReflect.Descriptor instead.
+func (*TLGatewayDeliveryResult) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TLGatewayDeliveryResult) GetData2() *GatewayDeliveryResult {
+	if x != nil {
+		return x.Data2
+	}
+	return nil
+}
+
 // --------------------------------------------------------------------------------------------
 type TLGatewaySendDataToGateway struct {
 	state         protoimpl.MessageState
@@ -85,27 +485,314 @@ type TLGatewaySendDataToGateway struct {
 
 	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
 	AuthKeyId   int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
-	SessionId   int64         `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
-	Payload     []byte        `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	SessionId   int64         `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Payload     []byte        `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *TLGatewaySendDataToGateway) Reset() {
+	*x = TLGatewaySendDataToGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewaySendDataToGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewaySendDataToGateway) ProtoMessage() {}
+
+func (x *TLGatewaySendDataToGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewaySendDataToGateway.ProtoReflect.Descriptor instead.
+func (*TLGatewaySendDataToGateway) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TLGatewaySendDataToGateway) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLGatewaySendDataToGateway) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *TLGatewaySendDataToGateway) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *TLGatewaySendDataToGateway) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// --------------------------------------------------------------------------------------------
+type TLGatewaySendDataToGatewayWithReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId   int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	SessionId   int64         `protobuf:"varint,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Payload     []byte        `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timeout     int32         `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) Reset() {
+	*x = TLGatewaySendDataToGatewayWithReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewaySendDataToGatewayWithReceipt) ProtoMessage() {}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewaySendDataToGatewayWithReceipt.ProtoReflect.Descriptor instead.
+func (*TLGatewaySendDataToGatewayWithReceipt) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *TLGatewaySendDataToGatewayWithReceipt) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+type TLGatewaySendBatchDataToGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor  `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	Items       []*GatewayData `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Timeout     int32          `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *TLGatewaySendBatchDataToGateway) Reset() {
+	*x = TLGatewaySendBatchDataToGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewaySendBatchDataToGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewaySendBatchDataToGateway) ProtoMessage() {}
+
+func (x *TLGatewaySendBatchDataToGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewaySendBatchDataToGateway.ProtoReflect.Descriptor instead.
+func (*TLGatewaySendBatchDataToGateway) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TLGatewaySendBatchDataToGateway) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLGatewaySendBatchDataToGateway) GetItems() []*GatewayData {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *TLGatewaySendBatchDataToGateway) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+type TLGatewayBroadcastDataToGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor   TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	PermAuthKeyId int64         `protobuf:"varint,3,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
+	Body          []byte        `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Timeout       int32         `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *TLGatewayBroadcastDataToGateway) Reset() {
+	*x = TLGatewayBroadcastDataToGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewayBroadcastDataToGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewayBroadcastDataToGateway) ProtoMessage() {}
+
+func (x *TLGatewayBroadcastDataToGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewayBroadcastDataToGateway.ProtoReflect.Descriptor instead.
+func (*TLGatewayBroadcastDataToGateway) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TLGatewayBroadcastDataToGateway) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLGatewayBroadcastDataToGateway) GetPermAuthKeyId() int64 {
+	if x != nil {
+		return x.PermAuthKeyId
+	}
+	return 0
+}
+
+func (x *TLGatewayBroadcastDataToGateway) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *TLGatewayBroadcastDataToGateway) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+type TLGatewayInvalidateAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId   int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Destroyed   bool          `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
 }
 
-func (x *TLGatewaySendDataToGateway) Reset() {
-	*x = TLGatewaySendDataToGateway{}
+func (x *TLGatewayInvalidateAuthKey) Reset() {
+	*x = TLGatewayInvalidateAuthKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_tl_proto_msgTypes[0]
+		mi := &file_gateway_tl_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TLGatewaySendDataToGateway) String() string {
+func (x *TLGatewayInvalidateAuthKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TLGatewaySendDataToGateway) ProtoMessage() {}
+func (*TLGatewayInvalidateAuthKey) ProtoMessage() {}
 
-func (x *TLGatewaySendDataToGateway) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_tl_proto_msgTypes[0]
+func (x *TLGatewayInvalidateAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,35 +803,180 @@ func (x *TLGatewaySendDataToGateway) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TLGatewaySendDataToGateway.ProtoReflect.Descriptor instead.
-func (*TLGatewaySendDataToGateway) Descriptor() ([]byte, []int) {
-	return file_gateway_tl_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use TLGatewayInvalidateAuthKey.ProtoReflect.Descriptor instead.
+func (*TLGatewayInvalidateAuthKey) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TLGatewaySendDataToGateway) GetConstructor() TLConstructor {
+func (x *TLGatewayInvalidateAuthKey) GetConstructor() TLConstructor {
 	if x != nil {
 		return x.Constructor
 	}
 	return TLConstructor_CRC32_UNKNOWN
 }
 
-func (x *TLGatewaySendDataToGateway) GetAuthKeyId() int64 {
+func (x *TLGatewayInvalidateAuthKey) GetAuthKeyId() int64 {
 	if x != nil {
 		return x.AuthKeyId
 	}
 	return 0
 }
 
-func (x *TLGatewaySendDataToGateway) GetSessionId() int64 {
+func (x *TLGatewayInvalidateAuthKey) GetDestroyed() bool {
 	if x != nil {
-		return x.SessionId
+		return x.Destroyed
+	}
+	return false
+}
+
+// --------------------------------------------------------------------------------------------
+type TLGatewayDrainGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=gateway.TLConstructor" json:"constructor,omitempty"`
+	Window      int32         `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (x *TLGatewayDrainGateway) Reset() {
+	*x = TLGatewayDrainGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLGatewayDrainGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLGatewayDrainGateway) ProtoMessage() {}
+
+func (x *TLGatewayDrainGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLGatewayDrainGateway.ProtoReflect.Descriptor instead.
+func (*TLGatewayDrainGateway) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TLGatewayDrainGateway) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLGatewayDrainGateway) GetWindow() int32 {
+	if x != nil {
+		return x.Window
 	}
 	return 0
 }
 
-func (x *TLGatewaySendDataToGateway) GetPayload() []byte {
+// --------------------------------------------------------------------------------------------
+// Vector api result type
+type Vector_DeliveryReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datas []*DeliveryReceipt `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+}
+
+func (x *Vector_DeliveryReceipt) Reset() {
+	*x = Vector_DeliveryReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vector_DeliveryReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector_DeliveryReceipt) ProtoMessage() {}
+
+func (x *Vector_DeliveryReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector_DeliveryReceipt.ProtoReflect.Descriptor instead.
+func (*Vector_DeliveryReceipt) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Vector_DeliveryReceipt) GetDatas() []*DeliveryReceipt {
 	if x != nil {
-		return x.Payload
+		return x.Datas
+	}
+	return nil
+}
+
+type Vector_GatewayDeliveryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datas []*GatewayDeliveryResult `protobuf:"bytes,1,rep,name=datas,proto3" json:"datas,omitempty"`
+}
+
+func (x *Vector_GatewayDeliveryResult) Reset() {
+	*x = Vector_GatewayDeliveryResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_tl_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vector_GatewayDeliveryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vector_GatewayDeliveryResult) ProtoMessage() {}
+
+func (x *Vector_GatewayDeliveryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_tl_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vector_GatewayDeliveryResult.ProtoReflect.Descriptor instead.
+func (*Vector_GatewayDeliveryResult) Descriptor() ([]byte, []int) {
+	return file_gateway_tl_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Vector_GatewayDeliveryResult) GetDatas() []*GatewayDeliveryResult {
+	if x != nil {
+		return x.Datas
 	}
 	return nil
 }
@@ -160,34 +992,201 @@ var file_gateway_tl_proto_rawDesc = []byte{
 	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
 	0x74, 0x6c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x74, 0x6c, 0x2e, 0x73, 0x79,
-	0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x01, 0x0a, 0x1c, 0x54, 0x4c, 0x5f,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
-	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x6e, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x63, 0x6f, 0x6e, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x44,
+	0x0a, 0x12, 0x54, 0x4c, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x61, 0x32, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3c,
+	0x0a, 0x0e, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x2a, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0xed, 0x01, 0x0a,
+	0x15, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x22, 0x50, 0x0a, 0x18,
+	0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0xb1,
+	0x01, 0x0a, 0x1c, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12,
+	0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
+	0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0xd6, 0x01, 0x0a, 0x27, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x38,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xa3, 0x01, 0x0a, 0x21,
+	0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x22, 0xb4, 0x01, 0x0a, 0x21, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72,
+	0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x54, 0x4c, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
 	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74,
 	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
 	0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
 	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65,
-	0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x4b, 0x0a, 0x0d,
-	0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x11, 0x0a,
-	0x0d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x27, 0x0a, 0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x10, 0x90, 0xf0, 0x81, 0xb4, 0x02, 0x32, 0x61, 0x0a, 0x0a, 0x52, 0x50, 0x43,
-	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x53, 0x0a, 0x19, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x12, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
-	0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61,
-	0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x0d, 0x2e, 0x6d, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67,
-	0x72, 0x61, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-	0x65, 0x2f, 0x67, 0x6e, 0x65, 0x74, 0x77, 0x61, 0x79, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65,
+	0x64, 0x22, 0x6b, 0x0a, 0x17, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x64, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x48,
+	0x0a, 0x16, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x22, 0x54, 0x0a, 0x1c, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x73, 0x2a, 0x95,
+	0x03, 0x0a, 0x0d, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x11, 0x0a, 0x0d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x15, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x10, 0xa2, 0x82, 0x92,
+	0x58, 0x12, 0x1e, 0x0a, 0x11, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x10, 0xdc, 0xec, 0xfa, 0xb8, 0xfe, 0xff, 0xff, 0xff, 0xff,
+	0x01, 0x12, 0x23, 0x0a, 0x1b, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x10, 0xc1, 0x9b, 0xf3, 0x92, 0x07, 0x12, 0x27, 0x0a, 0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x10, 0x90, 0xf0, 0x81, 0xb4, 0x02, 0x12,
+	0x37, 0x0a, 0x2a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x10, 0xba, 0x8b,
+	0xd0, 0xc1, 0xf8, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2c, 0x0a, 0x24, 0x43, 0x52, 0x43, 0x33,
+	0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x10, 0xc3, 0xc4, 0x93, 0xc2, 0x01, 0x12, 0x2c, 0x0a, 0x24, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x10, 0xfa,
+	0xdd, 0xa7, 0xca, 0x05, 0x12, 0x27, 0x0a, 0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x10, 0x9b, 0xdf, 0xcc, 0xe2, 0x03, 0x12, 0x27, 0x0a,
+	0x1a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64,
+	0x72, 0x61, 0x69, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x10, 0xa6, 0xc5, 0x8c, 0xdd,
+	0xfe, 0xff, 0xff, 0xff, 0xff, 0x01, 0x32, 0xec, 0x04, 0x0a, 0x0a, 0x52, 0x50, 0x43, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x53, 0x0a, 0x19, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x24, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x30, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x1e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61,
+	0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x2a, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x75,
+	0x0a, 0x1e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63,
+	0x61, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x12, 0x2a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x54, 0x6f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x25, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x47, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x25, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x12, 0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x4c, 0x5f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x65, 0x61,
+	0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70,
+	0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x67, 0x6e, 0x65, 0x74, 0x77,
+	0x61, 0x79, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -203,21 +1202,59 @@ func file_gateway_tl_proto_rawDescGZIP() []byte {
 }
 
 var file_gateway_tl_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_gateway_tl_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_gateway_tl_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_gateway_tl_proto_goTypes = []any{
-	(TLConstructor)(0),                 // 0: gateway.TLConstructor
-	(*TLGatewaySendDataToGateway)(nil), // 1: gateway.TL_gateway_sendDataToGateway
-	(*mtproto.Bool)(nil),               // 2: mtproto.Bool
+	(TLConstructor)(0),                            // 0: gateway.TLConstructor
+	(*DeliveryReceipt)(nil),                       // 1: gateway.DeliveryReceipt
+	(*TLDeliveryReceipt)(nil),                     // 2: gateway.TL_deliveryReceipt
+	(*GatewayData)(nil),                           // 3: gateway.GatewayData
+	(*TLGatewayData)(nil),                         // 4: gateway.TL_gatewayData
+	(*GatewayDeliveryResult)(nil),                 // 5: gateway.GatewayDeliveryResult
+	(*TLGatewayDeliveryResult)(nil),               // 6: gateway.TL_gatewayDeliveryResult
+	(*TLGatewaySendDataToGateway)(nil),            // 7: gateway.TL_gateway_sendDataToGateway
+	(*TLGatewaySendDataToGatewayWithReceipt)(nil), // 8: gateway.TL_gateway_sendDataToGatewayWithReceipt
+	(*TLGatewaySendBatchDataToGateway)(nil),       // 9: gateway.TL_gateway_sendBatchDataToGateway
+	(*TLGatewayBroadcastDataToGateway)(nil),       // 10: gateway.TL_gateway_broadcastDataToGateway
+	(*TLGatewayInvalidateAuthKey)(nil),            // 11: gateway.TL_gateway_invalidateAuthKey
+	(*TLGatewayDrainGateway)(nil),                 // 12: gateway.TL_gateway_drainGateway
+	(*Vector_DeliveryReceipt)(nil),                // 13: gateway.Vector_DeliveryReceipt
+	(*Vector_GatewayDeliveryResult)(nil),          // 14: gateway.Vector_GatewayDeliveryResult
+	(*mtproto.Bool)(nil),                          // 15: mtproto.Bool
 }
 var file_gateway_tl_proto_depIdxs = []int32{
-	0, // 0: gateway.TL_gateway_sendDataToGateway.constructor:type_name -> gateway.TLConstructor
-	1, // 1: gateway.RPCGateway.gateway_sendDataToGateway:input_type -> gateway.TL_gateway_sendDataToGateway
-	2, // 2: gateway.RPCGateway.gateway_sendDataToGateway:output_type -> mtproto.Bool
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: gateway.DeliveryReceipt.constructor:type_name -> gateway.TLConstructor
+	1,  // 1: gateway.TL_deliveryReceipt.data2:type_name -> gateway.DeliveryReceipt
+	0,  // 2: gateway.GatewayData.constructor:type_name -> gateway.TLConstructor
+	3,  // 3: gateway.TL_gatewayData.data2:type_name -> gateway.GatewayData
+	0,  // 4: gateway.GatewayDeliveryResult.constructor:type_name -> gateway.TLConstructor
+	1,  // 5: gateway.GatewayDeliveryResult.receipts:type_name -> gateway.DeliveryReceipt
+	5,  // 6: gateway.TL_gatewayDeliveryResult.data2:type_name -> gateway.GatewayDeliveryResult
+	0,  // 7: gateway.TL_gateway_sendDataToGateway.constructor:type_name -> gateway.TLConstructor
+	0,  // 8: gateway.TL_gateway_sendDataToGatewayWithReceipt.constructor:type_name -> gateway.TLConstructor
+	0,  // 9: gateway.TL_gateway_sendBatchDataToGateway.constructor:type_name -> gateway.TLConstructor
+	3,  // 10: gateway.TL_gateway_sendBatchDataToGateway.items:type_name -> gateway.GatewayData
+	0,  // 11: gateway.TL_gateway_broadcastDataToGateway.constructor:type_name -> gateway.TLConstructor
+	0,  // 12: gateway.TL_gateway_invalidateAuthKey.constructor:type_name -> gateway.TLConstructor
+	0,  // 13: gateway.TL_gateway_drainGateway.constructor:type_name -> gateway.TLConstructor
+	1,  // 14: gateway.Vector_DeliveryReceipt.datas:type_name -> gateway.DeliveryReceipt
+	5,  // 15: gateway.Vector_GatewayDeliveryResult.datas:type_name -> gateway.GatewayDeliveryResult
+	7,  // 16: gateway.RPCGateway.gateway_sendDataToGateway:input_type -> gateway.TL_gateway_sendDataToGateway
+	8,  // 17: gateway.RPCGateway.gateway_sendDataToGatewayWithReceipt:input_type -> gateway.TL_gateway_sendDataToGatewayWithReceipt
+	9,  // 18: gateway.RPCGateway.gateway_sendBatchDataToGateway:input_type -> gateway.TL_gateway_sendBatchDataToGateway
+	10, // 19: gateway.RPCGateway.gateway_broadcastDataToGateway:input_type -> gateway.TL_gateway_broadcastDataToGateway
+	11, // 20: gateway.RPCGateway.gateway_invalidateAuthKey:input_type -> gateway.TL_gateway_invalidateAuthKey
+	12, // 21: gateway.RPCGateway.gateway_drainGateway:input_type -> gateway.TL_gateway_drainGateway
+	15, // 22: gateway.RPCGateway.gateway_sendDataToGateway:output_type -> mtproto.Bool
+	13, // 23: gateway.RPCGateway.gateway_sendDataToGatewayWithReceipt:output_type -> gateway.Vector_DeliveryReceipt
+	14, // 24: gateway.RPCGateway.gateway_sendBatchDataToGateway:output_type -> gateway.Vector_GatewayDeliveryResult
+	14, // 25: gateway.RPCGateway.gateway_broadcastDataToGateway:output_type -> gateway.Vector_GatewayDeliveryResult
+	15, // 26: gateway.RPCGateway.gateway_invalidateAuthKey:output_type -> mtproto.Bool
+	15, // 27: gateway.RPCGateway.gateway_drainGateway:output_type -> mtproto.Bool
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_gateway_tl_proto_init() }
@@ -227,6 +1264,78 @@ func file_gateway_tl_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_gateway_tl_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*DeliveryReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*TLDeliveryReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GatewayData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewayData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*GatewayDeliveryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewayDeliveryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*TLGatewaySendDataToGateway); i {
 			case 0:
 				return &v.state
@@ -238,6 +1347,90 @@ func file_gateway_tl_proto_init() {
 				return nil
 			}
 		}
+		file_gateway_tl_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewaySendDataToGatewayWithReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewaySendBatchDataToGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewayBroadcastDataToGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewayInvalidateAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*TLGatewayDrainGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*Vector_DeliveryReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_tl_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*Vector_GatewayDeliveryResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -245,7 +1438,7 @@ func file_gateway_tl_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gateway_tl_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
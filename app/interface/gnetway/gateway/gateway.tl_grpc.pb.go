@@ -29,7 +29,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RPCGateway_GatewaySendDataToGateway_FullMethodName = "/gateway.RPCGateway/gateway_sendDataToGateway"
+	RPCGateway_GatewaySendDataToGateway_FullMethodName            = "/gateway.RPCGateway/gateway_sendDataToGateway"
+	RPCGateway_GatewaySendDataToGatewayWithReceipt_FullMethodName = "/gateway.RPCGateway/gateway_sendDataToGatewayWithReceipt"
+	RPCGateway_GatewaySendBatchDataToGateway_FullMethodName       = "/gateway.RPCGateway/gateway_sendBatchDataToGateway"
+	RPCGateway_GatewayBroadcastDataToGateway_FullMethodName       = "/gateway.RPCGateway/gateway_broadcastDataToGateway"
+	RPCGateway_GatewayInvalidateAuthKey_FullMethodName            = "/gateway.RPCGateway/gateway_invalidateAuthKey"
+	RPCGateway_GatewayDrainGateway_FullMethodName                 = "/gateway.RPCGateway/gateway_drainGateway"
 )
 
 // RPCGatewayClient is the client API for RPCGateway service.
@@ -37,6 +42,11 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type RPCGatewayClient interface {
 	GatewaySendDataToGateway(ctx context.Context, in *TLGatewaySendDataToGateway, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	GatewaySendDataToGatewayWithReceipt(ctx context.Context, in *TLGatewaySendDataToGatewayWithReceipt, opts ...grpc.CallOption) (*Vector_DeliveryReceipt, error)
+	GatewaySendBatchDataToGateway(ctx context.Context, in *TLGatewaySendBatchDataToGateway, opts ...grpc.CallOption) (*Vector_GatewayDeliveryResult, error)
+	GatewayBroadcastDataToGateway(ctx context.Context, in *TLGatewayBroadcastDataToGateway, opts ...grpc.CallOption) (*Vector_GatewayDeliveryResult, error)
+	GatewayInvalidateAuthKey(ctx context.Context, in *TLGatewayInvalidateAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	GatewayDrainGateway(ctx context.Context, in *TLGatewayDrainGateway, opts ...grpc.CallOption) (*mtproto.Bool, error)
 }
 
 type rPCGatewayClient struct {
@@ -56,11 +66,61 @@ func (c *rPCGatewayClient) GatewaySendDataToGateway(ctx context.Context, in *TLG
 	return out, nil
 }
 
+func (c *rPCGatewayClient) GatewaySendDataToGatewayWithReceipt(ctx context.Context, in *TLGatewaySendDataToGatewayWithReceipt, opts ...grpc.CallOption) (*Vector_DeliveryReceipt, error) {
+	out := new(Vector_DeliveryReceipt)
+	err := c.cc.Invoke(ctx, RPCGateway_GatewaySendDataToGatewayWithReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCGatewayClient) GatewaySendBatchDataToGateway(ctx context.Context, in *TLGatewaySendBatchDataToGateway, opts ...grpc.CallOption) (*Vector_GatewayDeliveryResult, error) {
+	out := new(Vector_GatewayDeliveryResult)
+	err := c.cc.Invoke(ctx, RPCGateway_GatewaySendBatchDataToGateway_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCGatewayClient) GatewayBroadcastDataToGateway(ctx context.Context, in *TLGatewayBroadcastDataToGateway, opts ...grpc.CallOption) (*Vector_GatewayDeliveryResult, error) {
+	out := new(Vector_GatewayDeliveryResult)
+	err := c.cc.Invoke(ctx, RPCGateway_GatewayBroadcastDataToGateway_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCGatewayClient) GatewayInvalidateAuthKey(ctx context.Context, in *TLGatewayInvalidateAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, RPCGateway_GatewayInvalidateAuthKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCGatewayClient) GatewayDrainGateway(ctx context.Context, in *TLGatewayDrainGateway, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, RPCGateway_GatewayDrainGateway_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCGatewayServer is the server API for RPCGateway service.
 // All implementations should embed UnimplementedRPCGatewayServer
 // for forward compatibility
 type RPCGatewayServer interface {
 	GatewaySendDataToGateway(context.Context, *TLGatewaySendDataToGateway) (*mtproto.Bool, error)
+	GatewaySendDataToGatewayWithReceipt(context.Context, *TLGatewaySendDataToGatewayWithReceipt) (*Vector_DeliveryReceipt, error)
+	GatewaySendBatchDataToGateway(context.Context, *TLGatewaySendBatchDataToGateway) (*Vector_GatewayDeliveryResult, error)
+	GatewayBroadcastDataToGateway(context.Context, *TLGatewayBroadcastDataToGateway) (*Vector_GatewayDeliveryResult, error)
+	GatewayInvalidateAuthKey(context.Context, *TLGatewayInvalidateAuthKey) (*mtproto.Bool, error)
+	GatewayDrainGateway(context.Context, *TLGatewayDrainGateway) (*mtproto.Bool, error)
 }
 
 // UnimplementedRPCGatewayServer should be embedded to have forward compatible implementations.
@@ -70,6 +130,21 @@ type UnimplementedRPCGatewayServer struct {
 func (UnimplementedRPCGatewayServer) GatewaySendDataToGateway(context.Context, *TLGatewaySendDataToGateway) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GatewaySendDataToGateway not implemented")
 }
+func (UnimplementedRPCGatewayServer) GatewaySendDataToGatewayWithReceipt(context.Context, *TLGatewaySendDataToGatewayWithReceipt) (*Vector_DeliveryReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewaySendDataToGatewayWithReceipt not implemented")
+}
+func (UnimplementedRPCGatewayServer) GatewaySendBatchDataToGateway(context.Context, *TLGatewaySendBatchDataToGateway) (*Vector_GatewayDeliveryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewaySendBatchDataToGateway not implemented")
+}
+func (UnimplementedRPCGatewayServer) GatewayBroadcastDataToGateway(context.Context, *TLGatewayBroadcastDataToGateway) (*Vector_GatewayDeliveryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayBroadcastDataToGateway not implemented")
+}
+func (UnimplementedRPCGatewayServer) GatewayInvalidateAuthKey(context.Context, *TLGatewayInvalidateAuthKey) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayInvalidateAuthKey not implemented")
+}
+func (UnimplementedRPCGatewayServer) GatewayDrainGateway(context.Context, *TLGatewayDrainGateway) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayDrainGateway not implemented")
+}
 
 // UnsafeRPCGatewayServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RPCGatewayServer will
@@ -100,6 +175,96 @@ func _RPCGateway_GatewaySendDataToGateway_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCGateway_GatewaySendDataToGatewayWithReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewaySendDataToGatewayWithReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewaySendDataToGatewayWithReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCGateway_GatewaySendDataToGatewayWithReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewaySendDataToGatewayWithReceipt(ctx, req.(*TLGatewaySendDataToGatewayWithReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCGateway_GatewaySendBatchDataToGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewaySendBatchDataToGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewaySendBatchDataToGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCGateway_GatewaySendBatchDataToGateway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewaySendBatchDataToGateway(ctx, req.(*TLGatewaySendBatchDataToGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCGateway_GatewayBroadcastDataToGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewayBroadcastDataToGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewayBroadcastDataToGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCGateway_GatewayBroadcastDataToGateway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewayBroadcastDataToGateway(ctx, req.(*TLGatewayBroadcastDataToGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCGateway_GatewayInvalidateAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewayInvalidateAuthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewayInvalidateAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCGateway_GatewayInvalidateAuthKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewayInvalidateAuthKey(ctx, req.(*TLGatewayInvalidateAuthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCGateway_GatewayDrainGateway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLGatewayDrainGateway)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCGatewayServer).GatewayDrainGateway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCGateway_GatewayDrainGateway_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCGatewayServer).GatewayDrainGateway(ctx, req.(*TLGatewayDrainGateway))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RPCGateway_ServiceDesc is the grpc.ServiceDesc for RPCGateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -111,6 +276,26 @@ var RPCGateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "gateway_sendDataToGateway",
 			Handler:    _RPCGateway_GatewaySendDataToGateway_Handler,
 		},
+		{
+			MethodName: "gateway_sendDataToGatewayWithReceipt",
+			Handler:    _RPCGateway_GatewaySendDataToGatewayWithReceipt_Handler,
+		},
+		{
+			MethodName: "gateway_sendBatchDataToGateway",
+			Handler:    _RPCGateway_GatewaySendBatchDataToGateway_Handler,
+		},
+		{
+			MethodName: "gateway_broadcastDataToGateway",
+			Handler:    _RPCGateway_GatewayBroadcastDataToGateway_Handler,
+		},
+		{
+			MethodName: "gateway_invalidateAuthKey",
+			Handler:    _RPCGateway_GatewayInvalidateAuthKey_Handler,
+		},
+		{
+			MethodName: "gateway_drainGateway",
+			Handler:    _RPCGateway_GatewayDrainGateway_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gateway.tl.proto",
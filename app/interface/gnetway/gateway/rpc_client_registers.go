@@ -26,7 +26,12 @@ type RPCContextTuple struct {
 }
 
 var rpcContextRegisters = map[string]RPCContextTuple{
-	"TLGatewaySendDataToGateway": RPCContextTuple{"/mtproto.RPCGateway/gateway_sendDataToGateway", func() interface{} { return new(mtproto.Bool) }},
+	"TLGatewaySendDataToGateway":            RPCContextTuple{"/mtproto.RPCGateway/gateway_sendDataToGateway", func() interface{} { return new(mtproto.Bool) }},
+	"TLGatewaySendBatchDataToGateway":       RPCContextTuple{"/mtproto.RPCGateway/gateway_sendBatchDataToGateway", func() interface{} { return new(Vector_GatewayDeliveryResult) }},
+	"TLGatewayBroadcastDataToGateway":       RPCContextTuple{"/mtproto.RPCGateway/gateway_broadcastDataToGateway", func() interface{} { return new(Vector_GatewayDeliveryResult) }},
+	"TLGatewayInvalidateAuthKey":            RPCContextTuple{"/mtproto.RPCGateway/gateway_invalidateAuthKey", func() interface{} { return new(mtproto.Bool) }},
+	"TLGatewaySendDataToGatewayWithReceipt": RPCContextTuple{"/mtproto.RPCGateway/gateway_sendDataToGatewayWithReceipt", func() interface{} { return new(Vector_DeliveryReceipt) }},
+	"TLGatewayDrainGateway":                 RPCContextTuple{"/mtproto.RPCGateway/gateway_drainGateway", func() interface{} { return new(mtproto.Bool) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
@@ -0,0 +1,60 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright (c) 2024-present,  Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: Benqi (wubenqi@gmail.com)
+ */
+
+package gnetwayservice
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gnetway"
+
+	"github.com/cloudwego/kitex/client"
+	"github.com/cloudwego/kitex/client/callopt"
+)
+
+// Client is designed to provide IDL-compatible methods with call-option parameter for kitex framework.
+type Client interface {
+	GnetwaySendDataToGateway(ctx context.Context, req *gnetway.TLGnetwaySendDataToGateway, callOptions ...callopt.Option) (r *tg.Bool, err error)
+}
+
+// NewClient creates a client for the service defined in IDL.
+func NewClient(destService string, opts ...client.Option) (Client, error) {
+	var options []client.Option
+	options = append(options, client.WithDestService(destService))
+
+	options = append(options, opts...)
+
+	kc, err := client.NewClient(serviceInfo(), options...)
+	if err != nil {
+		return nil, err
+	}
+	return &kGnetwayClient{
+		kClient: newServiceClient(kc),
+	}, nil
+}
+
+// MustNewClient creates a client for the service defined in IDL. It panics if any error occurs.
+func MustNewClient(destService string, opts ...client.Option) Client {
+	kc, err := NewClient(destService, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return kc
+}
+
+type kGnetwayClient struct {
+	*kClient
+}
+
+func (p *kGnetwayClient) GnetwaySendDataToGateway(ctx context.Context, req *gnetway.TLGnetwaySendDataToGateway, callOptions ...callopt.Option) (r *tg.Bool, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GnetwaySendDataToGateway(ctx, req)
+}
@@ -11,17 +11,40 @@
 package gnetway_helper
 
 import (
+	"io"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 func init() {
 	zrpc.DontLogContentForMethod("/gateway.RPCGateway/gateway_sendDataToGateway")
+	zrpc.DontLogContentForMethod("/gateway.RPCGateway/gateway_sendDataToGatewayWithReceipt")
+	zrpc.DontLogContentForMethod("/gateway.RPCGateway/gateway_sendBatchDataToGateway")
+	zrpc.DontLogContentForMethod("/gateway.RPCGateway/gateway_broadcastDataToGateway")
 
 	zrpc.DontLogClientContentForMethod("/session.RPCSession/session_sendDataToSession")
 }
 
 type (
+	Config = config.Config
 	Server = server.Server
 )
+
+func New(configFile *string) *Server {
+	return server.New(configFile)
+}
+
+// NewInProcess creates a gnetway that calls the session hosted in the same process
+// through sessionClient, see server.NewInProcess.
+func NewInProcess(c Config, sessionClient sessionclient.SessionClient) *Server {
+	return server.NewInProcess(c, sessionClient)
+}
+
+// CheckConfig validates configFile and prints what the gnetway would start with to w.
+func CheckConfig(configFile string, w io.Writer) error {
+	return server.CheckConfig(configFile, w)
+}
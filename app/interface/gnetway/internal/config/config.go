@@ -10,15 +10,37 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
 	"github.com/teamgram/marmota/pkg/container2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
+	"github.com/teamgram/teamgram-server/v2/pkg/dcoption"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
+// Config of the gnetway, it serves gateway.RPCGateway over grpc on ListenOn. The
+// cmd/gnetway-kitex build serves gnetway.RPCgnetway over kitex on Kitex.ListenOn too,
+// unless Kitex.Disable, the others leave Kitex alone.
 type Config struct {
 	zrpc.RpcServerConf
+	Kitex   KitexServerConf `json:",optional"`
 	RSAKey  []RSAKey
 	Gnetway *GnetwayConfig
 	Session zrpc.RpcClientConf
+	// SessionSharding weighs the session nodes and ejects the ones failing
+	SessionSharding SessionShardingConfig
+	// SessionStream, when set, sends the session traffic over one stream per session node
+	SessionStream *streamlink.Config `json:",optional"`
+}
+
+// KitexServerConf is the kitex listener, an empty ListenOn is the kitex default 0.0.0.0:8888.
+type KitexServerConf struct {
+	ListenOn string `json:",optional"`
+	Disable  bool   `json:",optional"`
 }
 
 type RSAKey struct {
@@ -26,16 +48,74 @@ type RSAKey struct {
 	KeyFingerprint string
 }
 
-type GnetwayServer struct {
-	Proto     string `json:",default=tcp,options=tcp|websocket|http"`
-	Addresses []string
-}
+// GnetwayServer is a listener, with the dc_options the sessions advertise for it.
+type GnetwayServer = dcoption.Listener
 
 type GnetwayConfig struct {
-	Server     []GnetwayServer
-	Multicore  bool
-	SendBuf    int
-	ReceiveBuf int
+	Server       []GnetwayServer
+	Multicore    bool
+	SendBuf      int
+	ReceiveBuf   int
+	AuthKeyCache AuthKeyCacheConfig
+	Dispatch     DispatchConfig
+	Outbound     OutboundConfig
+	Idle         IdleConfig
+	Drain        DrainConfig
+}
+
+// AuthKeyCacheConfig sizes the auth key cache, Capacity is the total number of keys over all shards.
+type AuthKeyCacheConfig struct {
+	Shards      int           `json:",default=256"`
+	Capacity    int           `json:",default=2000000"`
+	TTL         time.Duration `json:",default=24h"`
+	NegativeTTL time.Duration `json:",default=30s"`
+}
+
+// SessionShardingConfig weighs the session nodes by address, 1..100, the session nodes
+// must have the same PeerWeights. It leaves a node out for EjectFor after it failed
+// EjectAfter calls in a row, 0 never ejects.
+type SessionShardingConfig struct {
+	Weights    map[string]int `json:",optional"`
+	EjectAfter int            `json:",default=5"`
+	EjectFor   time.Duration  `json:",default=30s"`
+}
+
+// DispatchConfig bounds the frames of one connection waiting for the session.
+// Past MaxPending the connection stops decoding its frames, gnet still reads the socket,
+// past MaxInboundBytes undecoded it is closed.
+type DispatchConfig struct {
+	MaxPending      int `json:",default=256"`
+	MaxInboundBytes int `json:",default=4194304"`
+}
+
+// IdleConfig is how long a connection may stay silent. PreHandshake is for the connections
+// without an auth key yet, PostHandshake for the tcp ones with one, Http for the ones of
+// the http and websocket listeners with one. Up to Jitter is added to the last two, the
+// clients of a restart don't all time out together.
+type IdleConfig struct {
+	PreHandshake  time.Duration `json:",default=30s"`
+	PostHandshake time.Duration `json:",default=5m"`
+	Http          time.Duration `json:",default=5m"`
+	Jitter        time.Duration `json:",default=10s"`
+}
+
+// DrainConfig is how a gnetway that stops, or is told to drain, lets its clients go. It
+// takes no new connection and closes the open ones spread over Window, the idle ones first.
+type DrainConfig struct {
+	Window time.Duration `json:",default=30s"`
+}
+
+// The SlowConsumer policies of OutboundConfig.
+const (
+	SlowConsumerDrop  = "drop"
+	SlowConsumerClose = "close"
+)
+
+// OutboundConfig bounds what waits to be written to one connection. Past MaxPendingBytes
+// a connection is a slow consumer, drop loses its oldest updates first, close closes it.
+type OutboundConfig struct {
+	MaxPendingBytes int    `json:",default=4194304"`
+	SlowConsumer    string `json:",default=drop,options=drop|close"`
 }
 
 func (c GnetwayConfig) IsWebsocket(addr string) bool {
@@ -88,3 +168,88 @@ func (c GnetwayConfig) ToAddresses() []string {
 	}
 	return addresses
 }
+
+// Validate checks what the gnetway can't start without, conf2.Load calls it.
+func (c Config) Validate() error {
+	if err := c.RpcServerConf.Validate(); err != nil {
+		return err
+	}
+
+	if !c.Kitex.Disable && c.Kitex.ListenOn != "" {
+		if _, err := net.ResolveTCPAddr("tcp", c.Kitex.ListenOn); err != nil {
+			return fmt.Errorf("Kitex.ListenOn: %v", err)
+		}
+		if conf2.ListenConflict(c.Kitex.ListenOn, c.ListenOn) {
+			return fmt.Errorf("Kitex.ListenOn %s takes the port of ListenOn %s", c.Kitex.ListenOn, c.ListenOn)
+		}
+	}
+
+	if len(c.RSAKey) == 0 {
+		return errors.New("no RSAKey")
+	}
+	fingerprints := make(map[uint64]struct{}, len(c.RSAKey))
+	for i, k := range c.RSAKey {
+		if k.KeyFile == "" {
+			return fmt.Errorf("RSAKey[%d]: no KeyFile", i)
+		}
+		fingerprint, err := k.ParseKeyFingerprint()
+		if err != nil {
+			return fmt.Errorf("RSAKey[%d]: invalid KeyFingerprint %q", i, k.KeyFingerprint)
+		}
+		if _, ok := fingerprints[fingerprint]; ok {
+			return fmt.Errorf("RSAKey[%d]: KeyFingerprint %d used twice", i, fingerprint)
+		}
+		fingerprints[fingerprint] = struct{}{}
+
+		computed, err := k.ComputeKeyFingerprint()
+		if err != nil {
+			return fmt.Errorf("RSAKey[%d]: %v", i, err)
+		}
+		if computed != fingerprint {
+			return fmt.Errorf("RSAKey[%d]: KeyFingerprint %d is not the one of %s, %d", i, fingerprint, k.KeyFile, computed)
+		}
+	}
+
+	if c.Gnetway == nil {
+		return errors.New("no Gnetway")
+	}
+
+	return c.Gnetway.Validate()
+}
+
+// Validate checks the listeners, there must be one at least and no address may be
+// listened on twice, whatever the protocol.
+func (c GnetwayConfig) Validate() error {
+	protos := make(map[string]string)
+	for i, server := range c.Server {
+		for _, address := range server.Addresses {
+			if _, _, err := net.SplitHostPort(address); err != nil {
+				return fmt.Errorf("Gnetway.Server[%d]: %v", i, err)
+			}
+			if proto, ok := protos[address]; ok {
+				return fmt.Errorf("Gnetway.Server[%d]: %s is a %s listener already", i, address, proto)
+			}
+			protos[address] = server.Proto
+		}
+	}
+	if len(protos) == 0 {
+		return errors.New("no listener in Gnetway.Server")
+	}
+
+	if c.Idle.PreHandshake < time.Second || c.Idle.PostHandshake < time.Second || c.Idle.Http < time.Second {
+		return errors.New("Gnetway.Idle timeouts must be 1s at least")
+	}
+	if c.Idle.Jitter < 0 {
+		return errors.New("Gnetway.Idle.Jitter must not be negative")
+	}
+
+	if c.Drain.Window < 0 {
+		return errors.New("Gnetway.Drain.Window must not be negative")
+	}
+
+	if c.Outbound.MaxPendingBytes <= 0 {
+		return errors.New("Gnetway.Outbound.MaxPendingBytes must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// tlBytes serializes b as a TL bytes, by hand and not with mtproto.EncodeBuf.
+func tlBytes(b []byte) []byte {
+	var out []byte
+	if len(b) < 254 {
+		out = append(out, byte(len(b)))
+	} else {
+		out = append(out, 254, byte(len(b)), byte(len(b)>>8), byte(len(b)>>16))
+	}
+	out = append(out, b...)
+	for len(out)%4 != 0 {
+		out = append(out, 0)
+	}
+	return out
+}
+
+// writeKey writes key as pkcs1 or pkcs8 and returns the file with the fingerprint of key.
+func writeKey(t *testing.T, dir, name string, key *rsa.PrivateKey, pkcs8 bool) (string, uint64) {
+	t.Helper()
+
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if pkcs8 {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+
+	file := filepath.Join(dir, name)
+	if err := os.WriteFile(file, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	data := append(tlBytes(key.N.Bytes()), tlBytes(big.NewInt(int64(key.E)).Bytes())...)
+	hash := sha1.Sum(data)
+
+	return file, binary.LittleEndian.Uint64(hash[12:20])
+}
+
+func TestComputeKeyFingerprint(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	for _, pkcs8 := range []bool{false, true} {
+		file, want := writeKey(t, dir, "key"+strconv.FormatBool(pkcs8), key, pkcs8)
+
+		got, err := RSAKey{KeyFile: file}.ComputeKeyFingerprint()
+		if err != nil {
+			t.Fatalf("pkcs8 %v: ComputeKeyFingerprint() error: %v", pkcs8, err)
+		}
+		if got != want {
+			t.Fatalf("pkcs8 %v: ComputeKeyFingerprint() = %d, want %d", pkcs8, got, want)
+		}
+	}
+
+	notPem := filepath.Join(dir, "not.pem")
+	_ = os.WriteFile(notPem, []byte("not a key"), 0o600)
+	if _, err = (RSAKey{KeyFile: notPem}).ComputeKeyFingerprint(); err == nil {
+		t.Fatalf("ComputeKeyFingerprint() of a file without pem data succeeded")
+	}
+}
+
+func validGnetwayConfig() GnetwayConfig {
+	return GnetwayConfig{
+		Server: []GnetwayServer{
+			{Proto: "tcp", Addresses: []string{"0.0.0.0:10443", "0.0.0.0:5222"}},
+			{Proto: "websocket", Addresses: []string{"0.0.0.0:11443"}},
+		},
+		Idle: IdleConfig{
+			PreHandshake:  30 * time.Second,
+			PostHandshake: 5 * time.Minute,
+			Http:          5 * time.Minute,
+			Jitter:        10 * time.Second,
+		},
+		Drain:    DrainConfig{Window: 30 * time.Second},
+		Outbound: OutboundConfig{MaxPendingBytes: 4 << 20, SlowConsumer: SlowConsumerDrop},
+	}
+}
+
+func TestGnetwayConfigValidate(t *testing.T) {
+	cases := []struct {
+		name    string
+		change  func(c *GnetwayConfig)
+		wantErr string
+	}{
+		{"valid", func(c *GnetwayConfig) {}, ""},
+		{"no listener", func(c *GnetwayConfig) { c.Server = nil }, "no listener in Gnetway.Server"},
+		{"invalid address", func(c *GnetwayConfig) { c.Server[1].Addresses = []string{"11443"} }, "Gnetway.Server[1]: "},
+		{"address listened on twice", func(c *GnetwayConfig) { c.Server[1].Addresses = []string{"0.0.0.0:5222"} }, "Gnetway.Server[1]: 0.0.0.0:5222 is a tcp listener already"},
+		{"idle timeout too short", func(c *GnetwayConfig) { c.Idle.Http = time.Millisecond }, "Gnetway.Idle timeouts must be 1s at least"},
+		{"negative jitter", func(c *GnetwayConfig) { c.Idle.Jitter = -time.Second }, "Gnetway.Idle.Jitter must not be negative"},
+		{"negative drain window", func(c *GnetwayConfig) { c.Drain.Window = -time.Second }, "Gnetway.Drain.Window must not be negative"},
+		{"no outbound bytes", func(c *GnetwayConfig) { c.Outbound.MaxPendingBytes = 0 }, "Gnetway.Outbound.MaxPendingBytes must be positive"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := validGnetwayConfig()
+			tc.change(&c)
+			checkErr(t, c.Validate(), tc.wantErr)
+		})
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	var (
+		dir  = t.TempDir()
+		keys [2]*rsa.PrivateKey
+	)
+	for i := range keys {
+		key, err := rsa.GenerateKey(rand.Reader, 1024)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+	}
+	file1, fingerprint1 := writeKey(t, dir, "key1", keys[0], false)
+	file2, fingerprint2 := writeKey(t, dir, "key2", keys[1], true)
+
+	valid := func() Config {
+		gnetway := validGnetwayConfig()
+		c := Config{
+			RSAKey: []RSAKey{
+				{KeyFile: file1, KeyFingerprint: strconv.FormatUint(fingerprint1, 10)},
+				{KeyFile: file2, KeyFingerprint: strconv.FormatUint(fingerprint2, 10)},
+			},
+			Gnetway: &gnetway,
+		}
+		c.ListenOn = "127.0.0.1:20110"
+		return c
+	}
+
+	cases := []struct {
+		name    string
+		change  func(c *Config)
+		wantErr string
+	}{
+		{"valid", func(c *Config) {}, ""},
+		{"kitex on the grpc address", func(c *Config) { c.Kitex.ListenOn = c.ListenOn }, "Kitex.ListenOn 127.0.0.1:20110 takes the port of ListenOn 127.0.0.1:20110"},
+		{"kitex on all the addresses of the grpc port", func(c *Config) { c.Kitex.ListenOn = ":20110" }, "Kitex.ListenOn :20110 takes the port of ListenOn 127.0.0.1:20110"},
+		{"grpc on all the addresses of the kitex port", func(c *Config) { c.ListenOn, c.Kitex.ListenOn = "0.0.0.0:20110", "127.0.0.1:20110" }, "Kitex.ListenOn 127.0.0.1:20110 takes the port of ListenOn 0.0.0.0:20110"},
+		{"kitex on another port", func(c *Config) { c.Kitex.ListenOn = "0.0.0.0:20111" }, ""},
+		{"kitex on another address of the grpc port", func(c *Config) { c.Kitex.ListenOn = "127.0.0.2:20110" }, ""},
+		{"kitex on the grpc address, disabled", func(c *Config) { c.Kitex.ListenOn, c.Kitex.Disable = c.ListenOn, true }, ""},
+		{"no rsa key", func(c *Config) { c.RSAKey = nil }, "no RSAKey"},
+		{"no key file", func(c *Config) { c.RSAKey[1].KeyFile = "" }, "RSAKey[1]: no KeyFile"},
+		{"hex fingerprint", func(c *Config) { c.RSAKey[0].KeyFingerprint = "0xa9e071c1771060cd" }, `RSAKey[0]: invalid KeyFingerprint "0xa9e071c1771060cd"`},
+		{"negative fingerprint", func(c *Config) { c.RSAKey[0].KeyFingerprint = "-6205835210776354611" }, `RSAKey[0]: invalid KeyFingerprint "-6205835210776354611"`},
+		{"fingerprint used twice", func(c *Config) { c.RSAKey[1] = c.RSAKey[0] }, "RSAKey[1]: KeyFingerprint " + strconv.FormatUint(fingerprint1, 10) + " used twice"},
+		{"fingerprint of another key", func(c *Config) { c.RSAKey[1].KeyFile = file1 }, "RSAKey[1]: KeyFingerprint " + strconv.FormatUint(fingerprint2, 10) + " is not the one of " + file1},
+		{"missing key file", func(c *Config) { c.RSAKey[0].KeyFile = filepath.Join(dir, "none") }, "RSAKey[0]: open "},
+		{"no gnetway", func(c *Config) { c.Gnetway = nil }, "no Gnetway"},
+		{"invalid gnetway", func(c *Config) { c.Gnetway.Server = nil }, "no listener in Gnetway.Server"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := valid()
+			tc.change(&c)
+			checkErr(t, c.Validate(), tc.wantErr)
+		})
+	}
+}
+
+// checkErr checks err starts with wantErr, "" wants no error.
+func checkErr(t *testing.T, err error, wantErr string) {
+	t.Helper()
+
+	switch {
+	case wantErr == "":
+		if err != nil {
+			t.Fatalf("Validate() error: %v", err)
+		}
+	case err == nil:
+		t.Fatalf("Validate() = nil, want %q", wantErr)
+	case !strings.HasPrefix(err.Error(), wantErr):
+		t.Fatalf("Validate() = %q, want %q", err, wantErr)
+	}
+}
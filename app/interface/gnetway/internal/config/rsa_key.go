@@ -0,0 +1,76 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package config
+
+import (
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// ParseKeyFingerprint parses KeyFingerprint, the decimal uint64 the clients know the key by.
+func (k RSAKey) ParseKeyFingerprint() (uint64, error) {
+	return strconv.ParseUint(k.KeyFingerprint, 10, 64)
+}
+
+// ComputeKeyFingerprint reads KeyFile and computes its fingerprint the way the clients do:
+// the lower 64 bits of the sha1 of the TL serialized public key.
+func (k RSAKey) ComputeKeyFingerprint() (uint64, error) {
+	data, err := os.ReadFile(k.KeyFile)
+	if err != nil {
+		return 0, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return 0, fmt.Errorf("%s: no pem data", k.KeyFile)
+	}
+
+	var key *rsa.PrivateKey
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		var v any
+		if v, err = x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+			var ok bool
+			if key, ok = v.(*rsa.PrivateKey); !ok {
+				err = fmt.Errorf("not a rsa key")
+			}
+		}
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", k.KeyFile, err)
+	}
+
+	x := mtproto.NewEncodeBuf(512)
+	x.StringBytes(key.N.Bytes())
+	x.StringBytes(big.NewInt(int64(key.E)).Bytes())
+	hash := sha1.Sum(x.GetBuf())
+
+	return binary.LittleEndian.Uint64(hash[12:20]), nil
+}
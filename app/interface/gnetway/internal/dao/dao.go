@@ -8,14 +8,21 @@ package dao
 
 import (
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 )
 
 type Dao struct {
 	*ShardingSessionClient
 }
 
-func New(c config.Config) *Dao {
+func New(c config.Config, gatewayId string, sessionClient sessionclient.SessionClient) *Dao {
+	if sessionClient != nil {
+		return &Dao{
+			ShardingSessionClient: NewLocalSessionClient(c, gatewayId, sessionClient),
+		}
+	}
+
 	return &Dao{
-		ShardingSessionClient: NewShardingSessionClient(c),
+		ShardingSessionClient: NewShardingSessionClient(c, gatewayId),
 	}
 }
@@ -8,92 +8,298 @@ package dao
 
 import (
 	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
 	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/discovery"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
 
-	"github.com/zeromicro/go-zero/core/discov"
 	"github.com/zeromicro/go-zero/core/hash"
 	"github.com/zeromicro/go-zero/core/logx"
-	"github.com/zeromicro/go-zero/core/stringx"
 	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	localSessionAddr = "local"
 )
 
 var (
 	ErrSessionNotFound = errors.New("not found session")
 )
 
+// MovedKey is a shard key whose session node changed with the membership.
+type MovedKey struct {
+	Key  string
+	From string
+	To   string
+}
+
+// sessionNode is shared by the tables published while it is a member, its weight comes
+// from the config and only its health changes.
+type sessionNode struct {
+	addr         string
+	cli          sessionclient.SessionClient
+	failures     atomic.Int32
+	ejectedUntil atomic.Int64
+}
+
+func (n *sessionNode) ejected(now int64) bool {
+	return now < n.ejectedUntil.Load()
+}
+
+// routingTable is never changed once published, a membership change builds a new one.
+type routingTable struct {
+	ring  *hash.ConsistentHash
+	nodes map[string]*sessionNode
+}
+
+func (t *routingTable) get(key string) (*sessionNode, bool) {
+	val, ok := t.ring.Get(key)
+	if !ok {
+		return nil, false
+	}
+
+	n, ok := t.nodes[val.(string)]
+	return n, ok
+}
+
 type ShardingSessionClient struct {
-	gatewayId  string
-	dispatcher *hash.ConsistentHash
-	sessions   map[string]sessionclient.SessionClient
+	gatewayId string
+	c         config.SessionShardingConfig
+	stream    *streamlink.Config
+	handler   atomic.Value // streamlink.Handler
+	table     atomic.Pointer[routingTable]
+
+	// mu serializes the membership changes, the hot path only loads table
+	mu        sync.Mutex
+	members   map[string]*sessionNode
+	movedKeys func() []string
+	onMoved   func(moved []MovedKey)
 }
 
-func NewShardingSessionClient(c config.Config) *ShardingSessionClient {
+func NewShardingSessionClient(c config.Config, gatewayId string) *ShardingSessionClient {
 	sess := &ShardingSessionClient{
-		dispatcher: hash.NewConsistentHash(),
-		sessions:   make(map[string]sessionclient.SessionClient),
+		gatewayId: gatewayId,
+		c:         c.SessionSharding,
+		stream:    c.SessionStream,
+		members:   make(map[string]*sessionNode),
 	}
+	sess.table.Store(&routingTable{
+		ring:  hash.NewConsistentHash(),
+		nodes: map[string]*sessionNode{},
+	})
 	sess.watch(c.Session)
 
 	return sess
 }
 
+// NewLocalSessionClient sends every key to cli, the session hosted in the same process.
+func NewLocalSessionClient(c config.Config, gatewayId string, cli sessionclient.SessionClient) *ShardingSessionClient {
+	sess := &ShardingSessionClient{
+		gatewayId: gatewayId,
+		c:         c.SessionSharding,
+		members: map[string]*sessionNode{
+			localSessionAddr: {
+				addr: localSessionAddr,
+				cli:  cli,
+			},
+		},
+	}
+	sess.rebuildLocked()
+
+	return sess
+}
+
 func (sess *ShardingSessionClient) watch(c zrpc.RpcClientConf) {
-	sub, _ := discov.NewSubscriber(c.Etcd.Hosts, c.Etcd.Key)
-	update := func() {
-		var (
-			addClis    []string
-			removeClis []string
-		)
+	sub, err := discovery.NewSubscriber(c)
+	if err != nil {
+		logx.Errorf("watch session(%v) error: %v", c, err)
+		return
+	}
 
+	update := func() {
 		values := sub.Values()
-		sessions := map[string]sessionclient.SessionClient{}
+
+		sess.mu.Lock()
+		defer sess.mu.Unlock()
+
+		members := make(map[string]*sessionNode, len(values))
 		for _, v := range values {
-			if old, ok := sess.sessions[v]; ok {
-				sessions[v] = old
+			if old, ok := sess.members[v]; ok {
+				members[v] = old
 				continue
 			}
+
 			c.Endpoints = []string{v}
-			cli, err := zrpc.NewClient(c)
-			if err != nil {
-				logx.Error("watchComet NewClient(%+v) error(%v)", values, err)
-				return
+			cli, err2 := zrpc.NewClient(c)
+			if err2 != nil {
+				// leave it out, the next update tries again
+				logx.Errorf("watch session - NewClient(%s) error: %v", v, err2)
+				continue
 			}
-			sessionCli := sessionclient.NewSessionClient(cli)
-			sessions[v] = sessionCli
 
-			addClis = append(addClis, v)
+			var sessionCli sessionclient.SessionClient
+			if sess.stream != nil {
+				sessionCli = sessionclient.NewSessionStreamClient(cli, *sess.stream, sess.gatewayId, sess.onStreamItem)
+			} else {
+				sessionCli = sessionclient.NewSessionClient(cli)
+			}
+			members[v] = &sessionNode{
+				addr: v,
+				cli:  sessionCli,
+			}
 		}
 
-		for key, _ := range sess.sessions {
-			if !stringx.Contains(values, key) {
-				removeClis = append(removeClis, key)
+		for addr, n := range sess.members {
+			if _, ok := members[addr]; ok {
+				continue
+			}
+			if closer, ok := n.cli.(io.Closer); ok {
+				_ = closer.Close()
 			}
 		}
 
-		for _, n := range addClis {
-			sess.dispatcher.Add(n)
+		sess.members = members
+		sess.rebuildLocked()
+	}
+
+	sub.AddListener(update)
+	update()
+}
+
+func (sess *ShardingSessionClient) rebuild() {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	sess.rebuildLocked()
+}
+
+// rebuildLocked publishes a table of the members not ejected, or of all of them if
+// every member is ejected, then reports the keys that moved.
+//
+// The session nodes build their ring with sessionclient.NewShardRing too, but know
+// nothing of the ejections: meanwhile the keys of an ejected node go to another node,
+// which serves them as if it owned them, and what they left there is lost once they go back.
+func (sess *ShardingSessionClient) rebuildLocked() {
+	var (
+		now   = time.Now().Unix()
+		nodes = make(map[string]*sessionNode, len(sess.members))
+		addrs = make([]string, 0, len(sess.members))
+	)
+
+	for addr, n := range sess.members {
+		if !n.ejected(now) {
+			nodes[addr] = n
+		}
+	}
+	if len(nodes) == 0 {
+		for addr, n := range sess.members {
+			nodes[addr] = n
 		}
+	}
+	for addr := range nodes {
+		addrs = append(addrs, addr)
+	}
+
+	table := &routingTable{
+		ring:  sessionclient.NewShardRing(addrs, sessionclient.ShardWeights(sess.c.Weights)),
+		nodes: nodes,
+	}
 
-		for _, n := range removeClis {
-			sess.dispatcher.Remove(n)
+	old := sess.table.Swap(table)
+
+	if sess.movedKeys == nil || sess.onMoved == nil {
+		return
+	}
+
+	var moved []MovedKey
+	for _, key := range sess.movedKeys() {
+		from, _ := old.get(key)
+		to, _ := table.get(key)
+		if from == to {
+			continue
 		}
 
-		sess.sessions = sessions
+		m := MovedKey{Key: key}
+		if from != nil {
+			m.From = from.addr
+		}
+		if to != nil {
+			m.To = to.addr
+		}
+		moved = append(moved, m)
 	}
+	if len(moved) > 0 {
+		sess.onMoved(moved)
+	}
+}
 
-	sub.AddListener(update)
-	update()
+// SetMovedKeysHook makes every membership change check the shard keys returned by keys
+// and report the ones owned by another session node now to onMoved.
+func (sess *ShardingSessionClient) SetMovedKeysHook(keys func() []string, onMoved func(moved []MovedKey)) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	sess.movedKeys = keys
+	sess.onMoved = onMoved
 }
 
-func (sess *ShardingSessionClient) InvokeByKey(key string, cb func(client sessionclient.SessionClient) (err error)) error {
-	val, ok := sess.dispatcher.Get(key)
+// report counts the calls a node failed in a row, once there are EjectAfter of them
+// it is left out of the table for EjectFor.
+func (sess *ShardingSessionClient) report(n *sessionNode, err error) {
+	if status.Code(err) != codes.Unavailable {
+		n.failures.Store(0)
+		return
+	}
+
+	if sess.c.EjectAfter <= 0 || n.failures.Add(1) < int32(sess.c.EjectAfter) {
+		return
+	}
+
+	now := time.Now().Unix()
+	if n.ejected(now) {
+		return
+	}
+
+	logx.Errorf("session(%s) failed %d calls in a row, eject it for %s", n.addr, n.failures.Load(), sess.c.EjectFor)
+	n.failures.Store(0)
+	n.ejectedUntil.Store(now + int64(sess.c.EjectFor/time.Second))
+
+	go sess.rebuild()
+	time.AfterFunc(sess.c.EjectFor, sess.rebuild)
+}
+
+// SetStreamHandler sets who handles what the session nodes send over their streams,
+// the gnet server isn't there yet when the streams are opened.
+func (sess *ShardingSessionClient) SetStreamHandler(handler streamlink.Handler) {
+	sess.handler.Store(handler)
+}
+
+func (sess *ShardingSessionClient) onStreamItem(item *session.SessionStreamItem) {
+	handler, ok := sess.handler.Load().(streamlink.Handler)
 	if !ok {
-		return ErrSessionNotFound
+		logx.Errorf("session stream - no handler, drop item: %v", item)
+		return
 	}
 
-	cli, ok := sess.sessions[val.(string)]
+	handler(item)
+}
+
+// InvokeByAuthKey calls the session node owning the auth key, see sessionclient.ShardKey.
+// permAuthKeyId is 0 if the caller doesn't know it.
+func (sess *ShardingSessionClient) InvokeByAuthKey(authKeyId, permAuthKeyId int64, cb func(client sessionclient.SessionClient) (err error)) error {
+	return sess.InvokeByKey(sessionclient.ShardKey(authKeyId, permAuthKeyId), cb)
+}
+
+func (sess *ShardingSessionClient) InvokeByKey(key string, cb func(client sessionclient.SessionClient) (err error)) error {
+	n, ok := sess.table.Load().get(key)
 	if !ok {
 		return ErrSessionNotFound
 	}
@@ -102,5 +308,8 @@ func (sess *ShardingSessionClient) InvokeByKey(key string, cb func(client sessio
 		return nil
 	}
 
-	return cb(cli)
+	err := cb(n.cli)
+	sess.report(n, err)
+
+	return err
 }
@@ -0,0 +1,56 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
+)
+
+// CheckConfig loads and validates configFile, then prints the listeners and the keys
+// the gnetway would start with to w.
+func CheckConfig(configFile string, w io.Writer) error {
+	var c config.Config
+	if err := conf2.Load(configFile, &c); err != nil {
+		return fmt.Errorf("config file %s: %v", configFile, err)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "config file %s is valid\n\n", configFile)
+
+	fmt.Fprintln(tw, "PROTO\tLISTEN ON\tDC")
+	for _, server := range c.Gnetway.Server {
+		for _, address := range server.Addresses {
+			fmt.Fprintf(tw, "%s\t%s\t%d\n", server.Proto, address, server.DcId)
+		}
+	}
+	fmt.Fprintln(tw)
+
+	fmt.Fprintln(tw, "KEY FINGERPRINT\tHEX\tKEY FILE")
+	for _, k := range c.RSAKey {
+		fingerprint, _ := k.ParseKeyFingerprint()
+		fmt.Fprintf(tw, "%d\t%016x\t%s\n", fingerprint, fingerprint, k.KeyFile)
+	}
+
+	return tw.Flush()
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+)
+
+const testConfig = `Name: interface.gateway
+ListenOn: 127.0.0.1:20110
+Kitex:
+  ListenOn: 127.0.0.1:20111
+RSAKey:
+  - KeyFile: %q
+    KeyFingerprint: "%s"
+Gnetway:
+  Server:
+    - Proto: tcp
+      Addresses:
+        - 0.0.0.0:10443
+      IPs:
+        - 127.0.0.1
+    - Proto: websocket
+      Addresses:
+        - 0.0.0.0:8801
+  SendBuf: 65536
+  ReceiveBuf: 65536
+  Multicore: false
+Session:
+  Endpoints:
+    - 127.0.0.1:20120
+%s`
+
+func TestCheckConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "server_pkcs1.key")
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	fingerprint, err := config.RSAKey{KeyFile: keyFile}.ComputeKeyFingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name        string
+		fingerprint string
+		extra       string
+		wantErr     string
+		wantOut     []string
+	}{
+		{
+			name:        "valid",
+			fingerprint: fmt.Sprint(fingerprint),
+			wantOut: []string{
+				"is valid",
+				"tcp        0.0.0.0:10443",
+				"websocket  0.0.0.0:8801",
+				fmt.Sprintf("%d  %016x  %s", fingerprint, fingerprint, keyFile),
+			},
+		},
+		{
+			name:        "wrong fingerprint",
+			fingerprint: fmt.Sprint(fingerprint + 1),
+			wantErr:     fmt.Sprintf("RSAKey[0]: KeyFingerprint %d is not the one of %s, %d", fingerprint+1, keyFile, fingerprint),
+		},
+		{
+			name:        "unknown key",
+			fingerprint: fmt.Sprint(fingerprint),
+			extra:       "SessionShardng:\n  EjectAfter: 3\n",
+			wantErr:     "unknown keys: SessionShardng",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "gnetway.yaml")
+			content := fmt.Sprintf(testConfig, keyFile, tc.fingerprint, tc.extra)
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			var out bytes.Buffer
+			err := CheckConfig(file, &out)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("CheckConfig() = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CheckConfig() error: %v", err)
+			}
+			for _, want := range tc.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output misses %q:\n%s", want, out.String())
+				}
+			}
+		})
+	}
+}
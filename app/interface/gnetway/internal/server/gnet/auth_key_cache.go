@@ -0,0 +1,329 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"container/list"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/metric"
+)
+
+var (
+	metricAuthKeyCacheTotal = metric.NewCounterVec(&metric.CounterVecOpts{
+		Namespace: "gnetway",
+		Subsystem: "auth_key_cache",
+		Name:      "total",
+		Help:      "gnetway auth key cache lookups and evictions.",
+		Labels:    []string{"result"},
+	})
+)
+
+type authKeyCacheEntry struct {
+	authKeyId int64
+	value     CacheV
+	deadline  int64
+}
+
+func (e *authKeyCacheEntry) expired(now int64) bool {
+	return now >= e.deadline || e.value.Expired(now)
+}
+
+// authKeyCacheBound is the temp keys put bound to a perm key, with when each of them
+// leaves the cache at the latest.
+type authKeyCacheBound struct {
+	permAuthKeyId int64
+	temps         map[int64]int64
+}
+
+type authKeyCacheShard struct {
+	mu       sync.Mutex
+	items    map[int64]*list.Element
+	ll       *list.List
+	capacity int
+	// bound lists the temp keys put bound to a perm key of this shard, an lru of its
+	// own of capacity perm keys
+	bound   map[int64]*list.Element
+	boundLL *list.List
+}
+
+// authKeyCache is an int64 keyed lru, split into shards to keep lock contention low
+// with millions of connected keys. Every entry has a ttl, temp keys also expire at
+// their expires_in. A nil CacheV.V is a negative entry for a key the session doesn't know.
+//
+// Entries expire lazily, Get drops an expired entry and a full shard drops its least
+// recently used ones, so nothing has to walk the shards.
+type authKeyCache struct {
+	shards      []*authKeyCacheShard
+	mask        uint64
+	ttl         int64
+	negativeTTL int64
+
+	hits      atomic.Int64
+	misses    atomic.Int64
+	negatives atomic.Int64
+	evictions atomic.Int64
+}
+
+func newAuthKeyCache(c config.AuthKeyCacheConfig) *authKeyCache {
+	shards := 1
+	for shards < c.Shards {
+		shards <<= 1
+	}
+
+	capacity := c.Capacity / shards
+	if capacity <= 0 {
+		capacity = 1
+	}
+
+	kc := &authKeyCache{
+		shards:      make([]*authKeyCacheShard, shards),
+		mask:        uint64(shards - 1),
+		ttl:         int64(c.TTL / time.Second),
+		negativeTTL: int64(c.NegativeTTL / time.Second),
+	}
+	for i := range kc.shards {
+		kc.shards[i] = &authKeyCacheShard{
+			items:    make(map[int64]*list.Element),
+			ll:       list.New(),
+			capacity: capacity,
+			bound:    make(map[int64]*list.Element),
+			boundLL:  list.New(),
+		}
+	}
+
+	return kc
+}
+
+func (kc *authKeyCache) shard(authKeyId int64) *authKeyCacheShard {
+	h := uint64(authKeyId)
+	h ^= h >> 32
+	return kc.shards[h&kc.mask]
+}
+
+// Get returns the entry of authKeyId, ok is false on a miss.
+func (kc *authKeyCache) Get(authKeyId int64) (v CacheV, ok bool) {
+	var (
+		now     = time.Now().Unix()
+		s       = kc.shard(authKeyId)
+		expired bool
+	)
+
+	s.mu.Lock()
+	if e, ok2 := s.items[authKeyId]; ok2 {
+		entry := e.Value.(*authKeyCacheEntry)
+		if entry.expired(now) {
+			s.removeElement(e)
+			expired = true
+		} else {
+			s.ll.MoveToFront(e)
+			v, ok = entry.value, true
+		}
+	}
+	s.mu.Unlock()
+
+	if expired {
+		kc.evictions.Add(1)
+		metricAuthKeyCacheTotal.Inc("expire")
+	}
+
+	switch {
+	case !ok:
+		kc.misses.Add(1)
+		metricAuthKeyCacheTotal.Inc("miss")
+	case v.V == nil:
+		kc.negatives.Add(1)
+		metricAuthKeyCacheTotal.Inc("negative")
+	default:
+		kc.hits.Add(1)
+		metricAuthKeyCacheTotal.Inc("hit")
+	}
+
+	return
+}
+
+// Put caches keyInfo, expiresAt is the absolute expiry of a temp key or 0.
+func (kc *authKeyCache) Put(keyInfo *mtproto.AuthKeyInfo, expiresAt int64) {
+	now := time.Now().Unix()
+	kc.set(keyInfo.AuthKeyId, CacheV{V: keyInfo, ExpiresAt: expiresAt}, kc.ttl)
+	if keyInfo.PermAuthKeyId != 0 && keyInfo.PermAuthKeyId != keyInfo.AuthKeyId {
+		deadline := now + kc.ttl
+		if expiresAt > 0 && expiresAt < deadline {
+			deadline = expiresAt
+		}
+		kc.bind(keyInfo.PermAuthKeyId, keyInfo.AuthKeyId, deadline, now)
+	}
+}
+
+// bind lists tempAuthKeyId under the perm key it is bound to until deadline, for
+// RemoveBound. The temp keys of the list past their deadline are no longer cached,
+// they go meanwhile not to keep them forever.
+func (kc *authKeyCache) bind(permAuthKeyId, tempAuthKeyId, deadline, now int64) {
+	var (
+		s       = kc.shard(permAuthKeyId)
+		evicted int
+	)
+
+	s.mu.Lock()
+	b := s.getBound(permAuthKeyId)
+	if b == nil {
+		b = &authKeyCacheBound{permAuthKeyId: permAuthKeyId, temps: make(map[int64]int64)}
+		s.bound[permAuthKeyId] = s.boundLL.PushFront(b)
+		for s.boundLL.Len() > s.capacity {
+			s.removeBound(s.boundLL.Back())
+			evicted++
+		}
+	}
+	for id, at := range b.temps {
+		if now >= at {
+			delete(b.temps, id)
+		}
+	}
+	b.temps[tempAuthKeyId] = deadline
+	s.mu.Unlock()
+
+	if evicted > 0 {
+		metricAuthKeyCacheTotal.Add(float64(evicted), "evict_bound")
+	}
+}
+
+// RemoveBound drops the list of the temp keys put bound to permAuthKeyId and returns it,
+// the keys themselves stay cached.
+func (kc *authKeyCache) RemoveBound(permAuthKeyId int64) (temps []int64) {
+	s := kc.shard(permAuthKeyId)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.bound[permAuthKeyId]; ok {
+		for id := range e.Value.(*authKeyCacheBound).temps {
+			temps = append(temps, id)
+		}
+		s.removeBound(e)
+	}
+
+	return
+}
+
+// PutNegative remembers for a short while that the session doesn't know authKeyId.
+func (kc *authKeyCache) PutNegative(authKeyId int64) {
+	kc.set(authKeyId, CacheV{}, kc.negativeTTL)
+}
+
+// Remove drops authKeyId, e.g. after the key was destroyed on the session.
+func (kc *authKeyCache) Remove(authKeyId int64) bool {
+	s := kc.shard(authKeyId)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.items[authKeyId]; ok {
+		s.removeElement(e)
+		return true
+	}
+
+	return false
+}
+
+func (kc *authKeyCache) set(authKeyId int64, v CacheV, ttl int64) {
+	var (
+		deadline = time.Now().Unix() + ttl
+		s        = kc.shard(authKeyId)
+		evicted  int
+	)
+
+	s.mu.Lock()
+	if e, ok := s.items[authKeyId]; ok {
+		entry := e.Value.(*authKeyCacheEntry)
+		entry.value = v
+		entry.deadline = deadline
+		s.ll.MoveToFront(e)
+	} else {
+		s.items[authKeyId] = s.ll.PushFront(&authKeyCacheEntry{
+			authKeyId: authKeyId,
+			value:     v,
+			deadline:  deadline,
+		})
+		for s.ll.Len() > s.capacity {
+			s.removeElement(s.ll.Back())
+			evicted++
+		}
+	}
+	s.mu.Unlock()
+
+	if evicted > 0 {
+		kc.evictions.Add(int64(evicted))
+		metricAuthKeyCacheTotal.Add(float64(evicted), "evict")
+	}
+}
+
+func (kc *authKeyCache) Len() (n int) {
+	for _, s := range kc.shards {
+		s.mu.Lock()
+		n += s.ll.Len()
+		s.mu.Unlock()
+	}
+	return
+}
+
+// logStat logs the lookups since the last call, it is called once a minute.
+func (kc *authKeyCache) logStat() {
+	var (
+		hits      = kc.hits.Swap(0)
+		misses    = kc.misses.Swap(0)
+		negatives = kc.negatives.Swap(0)
+		total     = hits + misses + negatives
+	)
+
+	if total == 0 {
+		return
+	}
+
+	logx.Statf("auth_key_cache - qpm: %d, hit_ratio: %.1f%%, hit: %d, miss: %d, negative: %d, evictions: %d, elements: %d",
+		total,
+		float32(hits)*100/float32(total),
+		hits,
+		misses,
+		negatives,
+		kc.evictions.Load(),
+		kc.Len())
+}
+
+func (s *authKeyCacheShard) removeElement(e *list.Element) {
+	s.ll.Remove(e)
+	delete(s.items, e.Value.(*authKeyCacheEntry).authKeyId)
+}
+
+// getBound returns the temp keys bound to permAuthKeyId as used last, nil if none.
+func (s *authKeyCacheShard) getBound(permAuthKeyId int64) *authKeyCacheBound {
+	e, ok := s.bound[permAuthKeyId]
+	if !ok {
+		return nil
+	}
+	s.boundLL.MoveToFront(e)
+	return e.Value.(*authKeyCacheBound)
+}
+
+func (s *authKeyCacheShard) removeBound(e *list.Element) {
+	s.boundLL.Remove(e)
+	delete(s.bound, e.Value.(*authKeyCacheBound).permAuthKeyId)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+)
+
+func TestAuthKeyCacheTTL(t *testing.T) {
+	var (
+		now     = time.Now().Unix()
+		keyInfo = &mtproto.AuthKeyInfo{AuthKeyId: 1}
+	)
+
+	cases := []struct {
+		name      string
+		ttl       time.Duration
+		expiresAt int64
+		hit       bool
+	}{
+		{"perm key within ttl", time.Hour, 0, true},
+		{"perm key past ttl", 0, 0, false},
+		{"temp key not expired", time.Hour, now + 3600, true},
+		{"temp key expired", time.Hour, now - 1, false},
+		{"temp key expires after ttl", 0, now + 3600, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 1, Capacity: 16, TTL: tc.ttl})
+			kc.Put(keyInfo, tc.expiresAt)
+
+			v, ok := kc.Get(keyInfo.AuthKeyId)
+			if ok != tc.hit {
+				t.Fatalf("Get() ok = %v, want %v", ok, tc.hit)
+			}
+			if ok && (v.V != keyInfo || v.ExpiresAt != tc.expiresAt) {
+				t.Fatalf("Get() = %+v, want the key expiring at %d", v, tc.expiresAt)
+			}
+			if !ok && kc.Len() != 0 {
+				t.Fatalf("expired entry kept, Len() = %d", kc.Len())
+			}
+		})
+	}
+}
+
+func TestAuthKeyCacheNegative(t *testing.T) {
+	cases := []struct {
+		name        string
+		negativeTTL time.Duration
+		hit         bool
+	}{
+		{"within negative ttl", time.Minute, true},
+		{"past negative ttl", 0, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 1, Capacity: 16, TTL: time.Hour, NegativeTTL: tc.negativeTTL})
+			kc.PutNegative(1)
+
+			v, ok := kc.Get(1)
+			if ok != tc.hit {
+				t.Fatalf("Get() ok = %v, want %v", ok, tc.hit)
+			}
+			if ok && v.V != nil {
+				t.Fatalf("Get() = %+v, want a negative entry", v)
+			}
+		})
+	}
+
+	// a key set on the session replaces its negative entry
+	kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 1, Capacity: 16, TTL: time.Hour, NegativeTTL: time.Minute})
+	kc.PutNegative(1)
+	kc.Put(&mtproto.AuthKeyInfo{AuthKeyId: 1}, 0)
+	if v, ok := kc.Get(1); !ok || v.V == nil {
+		t.Fatalf("Get() = %+v, %v, want the key", v, ok)
+	}
+}
+
+func TestAuthKeyCacheShards(t *testing.T) {
+	cases := []struct {
+		shards, capacity         int
+		wantShards, wantCapacity int
+	}{
+		{0, 100, 1, 100},
+		{1, 100, 1, 100},
+		{3, 100, 4, 25},
+		{4, 100, 4, 25},
+		{5, 100, 8, 12},
+		{256, 2000000, 256, 7812},
+		{8, 2, 8, 1},
+	}
+
+	for _, tc := range cases {
+		kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: tc.shards, Capacity: tc.capacity})
+		if len(kc.shards) != tc.wantShards || kc.mask != uint64(tc.wantShards-1) {
+			t.Errorf("Shards %d: got %d shards, mask %d, want %d", tc.shards, len(kc.shards), kc.mask, tc.wantShards)
+		}
+		if c := kc.shards[0].capacity; c != tc.wantCapacity {
+			t.Errorf("Shards %d, Capacity %d: got capacity %d per shard, want %d", tc.shards, tc.capacity, c, tc.wantCapacity)
+		}
+	}
+}
+
+func TestAuthKeyCacheLRU(t *testing.T) {
+	kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 1, Capacity: 2, TTL: time.Hour})
+	kc.Put(&mtproto.AuthKeyInfo{AuthKeyId: 1}, 0)
+	kc.Put(&mtproto.AuthKeyInfo{AuthKeyId: 2}, 0)
+
+	// 1 was used last, 2 goes
+	kc.Get(1)
+	kc.Put(&mtproto.AuthKeyInfo{AuthKeyId: 3}, 0)
+
+	for id, want := range map[int64]bool{1: true, 2: false, 3: true} {
+		if _, ok := kc.Get(id); ok != want {
+			t.Errorf("Get(%d) ok = %v, want %v", id, ok, want)
+		}
+	}
+	if !kc.Remove(1) || kc.Remove(1) {
+		t.Errorf("Remove(1) should remove once")
+	}
+}
+
+func sortedBound(kc *authKeyCache, permAuthKeyId int64) []int64 {
+	temps := kc.RemoveBound(permAuthKeyId)
+	sort.Slice(temps, func(i, j int) bool { return temps[i] < temps[j] })
+	return temps
+}
+
+func TestAuthKeyCacheBoundConcurrent(t *testing.T) {
+	const n = 64
+
+	kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 4, Capacity: 1024, TTL: time.Hour})
+
+	var wg sync.WaitGroup
+	for i := int64(1); i <= n; i++ {
+		wg.Add(1)
+		go func(id int64) {
+			defer wg.Done()
+			kc.Put(&mtproto.AuthKeyInfo{AuthKeyId: 1000 + id, PermAuthKeyId: 1}, 0)
+		}(i)
+	}
+	wg.Wait()
+
+	temps := sortedBound(kc, 1)
+	if len(temps) != n {
+		t.Fatalf("RemoveBound() returned %d temp keys, want %d", len(temps), n)
+	}
+	for i, id := range temps {
+		if id != int64(1001+i) {
+			t.Fatalf("RemoveBound()[%d] = %d, want %d", i, id, 1001+i)
+		}
+	}
+	if temps := kc.RemoveBound(1); len(temps) != 0 {
+		t.Fatalf("RemoveBound() again = %v, want none", temps)
+	}
+}
+
+func TestAuthKeyCacheBound(t *testing.T) {
+	now := time.Now().Unix()
+
+	cases := []struct {
+		name      string
+		capacity  int
+		puts      []*mtproto.AuthKeyInfo
+		expiresAt []int64
+		perm      int64
+		want      []int64
+	}{
+		{
+			name:      "temp keys of the perm key",
+			capacity:  16,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 11, PermAuthKeyId: 1}, {AuthKeyId: 12, PermAuthKeyId: 1}, {AuthKeyId: 21, PermAuthKeyId: 2}},
+			expiresAt: []int64{now + 60, now + 60, now + 60},
+			perm:      1,
+			want:      []int64{11, 12},
+		},
+		{
+			name:      "bound again",
+			capacity:  16,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 11, PermAuthKeyId: 1}, {AuthKeyId: 11, PermAuthKeyId: 1}},
+			expiresAt: []int64{now + 60, now + 60},
+			perm:      1,
+			want:      []int64{11},
+		},
+		{
+			name:      "expired temp key dropped by the next bind",
+			capacity:  16,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 11, PermAuthKeyId: 1}, {AuthKeyId: 12, PermAuthKeyId: 1}},
+			expiresAt: []int64{now - 1, now + 60},
+			perm:      1,
+			want:      []int64{12},
+		},
+		{
+			name:      "perm key bound least recently evicted past capacity",
+			capacity:  2,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 11, PermAuthKeyId: 1}, {AuthKeyId: 21, PermAuthKeyId: 2}, {AuthKeyId: 31, PermAuthKeyId: 3}},
+			expiresAt: []int64{now + 60, now + 60, now + 60},
+			perm:      1,
+			want:      nil,
+		},
+		{
+			name:      "perm key bound again kept past capacity",
+			capacity:  2,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 11, PermAuthKeyId: 1}, {AuthKeyId: 21, PermAuthKeyId: 2}, {AuthKeyId: 12, PermAuthKeyId: 1}, {AuthKeyId: 31, PermAuthKeyId: 3}},
+			expiresAt: []int64{now + 60, now + 60, now + 60, now + 60},
+			perm:      1,
+			want:      []int64{11, 12},
+		},
+		{
+			name:      "perm key not bound",
+			capacity:  16,
+			puts:      []*mtproto.AuthKeyInfo{{AuthKeyId: 1}},
+			expiresAt: []int64{0},
+			perm:      1,
+			want:      nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			kc := newAuthKeyCache(config.AuthKeyCacheConfig{Shards: 1, Capacity: tc.capacity, TTL: time.Hour})
+			for i, keyInfo := range tc.puts {
+				kc.Put(keyInfo, tc.expiresAt[i])
+			}
+			if n := kc.shards[0].boundLL.Len(); n > tc.capacity {
+				t.Errorf("%d perm keys bound, capacity %d", n, tc.capacity)
+			}
+
+			got := sortedBound(kc, tc.perm)
+			if len(got) != len(tc.want) {
+				t.Fatalf("RemoveBound() = %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("RemoveBound() = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
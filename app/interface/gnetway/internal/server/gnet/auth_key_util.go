@@ -25,8 +25,9 @@ type AuthKeyUtil = authKeyUtil
 var NewAuthKeyUtil = newAuthKeyUtil
 
 type authKeyUtil struct {
-	keyData *mtproto.AuthKeyInfo
-	key     *crypto.AuthKey
+	keyData   *mtproto.AuthKeyInfo
+	key       *crypto.AuthKey
+	expiresAt int64
 }
 
 func newAuthKeyUtil(k *mtproto.AuthKeyInfo) *authKeyUtil {
@@ -60,6 +61,14 @@ func (k *authKeyUtil) MediaTempAuthKeyId() int64 {
 	return k.keyData.MediaTempAuthKeyId
 }
 
+func (k *authKeyUtil) ExpiresAt() int64 {
+	return k.expiresAt
+}
+
+func (k *authKeyUtil) Expired(now int64) bool {
+	return k.expiresAt > 0 && now >= k.expiresAt
+}
+
 func (k *authKeyUtil) AesIgeEncrypt(rawData []byte) ([]byte, []byte, error) {
 	return k.key.AesIgeEncrypt(rawData)
 }
@@ -16,15 +16,60 @@
 package gnet
 
 import (
-	"container/list"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// The transport of a connection, the lower the better to deliver over.
+const (
+	connTransportTcp       = 1
+	connTransportWebsocket = 2
+)
+
+// sessionConn is a connection of a session and what delivery picks it by.
+type sessionConn struct {
+	connId    int64
+	transport int
+	// openedAt orders the connections of equal rank, the newer one wins
+	openedAt int64
+	// lastActive is the unix time of the last frame the client sent on it
+	lastActive atomic.Int64
+	// salt is the server salt of that frame
+	salt atomic.Int64
+	// keepAlive and rpc tell a push connection, one the client only keeps alive
+	// with ping_delay_disconnect, from one it calls methods on.
+	keepAlive atomic.Bool
+	rpc       atomic.Bool
+}
+
+func (c *sessionConn) push() bool {
+	return c.keepAlive.Load() && !c.rpc.Load()
+}
+
+// better reports whether c is more suitable than c2 to deliver to: push connections
+// first, then the most recently active, then by transport.
+func (c *sessionConn) better(c2 *sessionConn) bool {
+	if p, p2 := c.push(), c2.push(); p != p2 {
+		return p
+	}
+	if a, a2 := c.lastActive.Load(), c2.lastActive.Load(); a != a2 {
+		return a > a2
+	}
+	if c.transport != c2.transport {
+		return c.transport < c2.transport
+	}
+	return c.openedAt > c2.openedAt
+}
+
 type sessionData struct {
-	sessionId  int64
-	connIdList *list.List
+	sessionId int64
+	conns     map[int64]*sessionConn
 	// pendingHttpDataList *list.List
 }
 
@@ -44,7 +89,20 @@ func NewAuthSessionManager() *authSessionManager {
 	}
 }
 
-func (m *authSessionManager) AddNewSession(authKey *authKeyUtil, sessionId int64, connId int64) (bNew bool) {
+func newSessionConn(connId int64, transport int) *sessionConn {
+	now := time.Now()
+
+	c := &sessionConn{
+		connId:    connId,
+		transport: transport,
+		openedAt:  now.UnixNano(),
+	}
+	c.lastActive.Store(now.Unix())
+
+	return c
+}
+
+func (m *authSessionManager) AddNewSession(authKey *authKeyUtil, sessionId int64, connId int64, transport int) (bNew bool) {
 	logx.Debugf("addNewSession: auth_key_id: %d, session_id: %d, conn_id: %d",
 		authKey.AuthKeyId(),
 		sessionId,
@@ -54,42 +112,25 @@ func (m *authSessionManager) AddNewSession(authKey *authKeyUtil, sessionId int64
 	defer m.rw.Unlock()
 
 	if v, ok := m.sessions[authKey.AuthKeyId()]; ok {
-		var (
-			// sIdx     = -1
-			cExisted = false
-		)
 		if v2, ok2 := v.sessionList[sessionId]; ok2 {
-			for e := v2.connIdList.Front(); e != nil; e = e.Next() {
-				if e.Value.(int64) == connId {
-					cExisted = true
-					break
-				}
-			}
-			if !cExisted {
-				v2.connIdList.PushBack(connId)
+			if _, cExisted := v2.conns[connId]; !cExisted {
+				v2.conns[connId] = newSessionConn(connId, transport)
 			}
 		} else {
-			s := sessionData{
-				sessionId:  sessionId,
-				connIdList: list.New(),
-				// pendingHttpDataList: list.New(),
+			v.sessionList[sessionId] = sessionData{
+				sessionId: sessionId,
+				conns:     map[int64]*sessionConn{connId: newSessionConn(connId, transport)},
 			}
-			s.connIdList.PushBack(connId)
-			v.sessionList[sessionId] = s
 			bNew = true
 		}
 	} else {
-		s := sessionData{
-			sessionId:  sessionId,
-			connIdList: list.New(),
-			// pendingHttpDataList: list.New(),
-		}
-		s.connIdList.PushBack(connId)
-
 		m.sessions[authKey.AuthKeyId()] = &authSession{
 			authKey: authKey,
 			sessionList: map[int64]sessionData{
-				sessionId: s,
+				sessionId: {
+					sessionId: sessionId,
+					conns:     map[int64]*sessionConn{connId: newSessionConn(connId, transport)},
+				},
 			},
 		}
 		bNew = true
@@ -108,13 +149,8 @@ func (m *authSessionManager) RemoveSession(authKeyId, sessionId int64, connId in
 
 	if v, ok := m.sessions[authKeyId]; ok {
 		if v2, ok2 := v.sessionList[sessionId]; ok2 {
-			for e := v2.connIdList.Front(); e != nil; e = e.Next() {
-				if e.Value.(int64) == connId {
-					v2.connIdList.Remove(e)
-					break
-				}
-			}
-			if v2.connIdList.Len() == 0 {
+			delete(v2.conns, connId)
+			if len(v2.conns) == 0 {
 				delete(v.sessionList, sessionId)
 				bDeleted = true
 			}
@@ -127,19 +163,136 @@ func (m *authSessionManager) RemoveSession(authKeyId, sessionId int64, connId in
 	return
 }
 
+// TouchConn records a frame the client sent on connId with salt, keepAlive if it held
+// ping_delay_disconnect, rpc if it held anything but service messages.
+func (m *authSessionManager) TouchConn(authKeyId, sessionId int64, connId int64, salt int64, keepAlive, rpc bool) {
+	m.rw.RLock()
+	defer m.rw.RUnlock()
+
+	if v, ok := m.sessions[authKeyId]; ok {
+		if c, ok2 := v.sessionList[sessionId].conns[connId]; ok2 {
+			c.lastActive.Store(time.Now().Unix())
+			c.salt.Store(salt)
+			if keepAlive {
+				c.keepAlive.Store(true)
+			}
+			if rpc {
+				c.rpc.Store(true)
+			}
+		}
+	}
+}
+
+// FoundSessionConnId returns the connections of the session, the most suitable to
+// deliver to first.
 func (m *authSessionManager) FoundSessionConnId(authKeyId, sessionId int64) (*authKeyUtil, []int64) {
 	m.rw.RLock()
 	defer m.rw.RUnlock()
 
 	if v, ok := m.sessions[authKeyId]; ok {
 		if v2, ok2 := v.sessionList[sessionId]; ok2 {
-			connIdList := make([]int64, 0, v2.connIdList.Len())
-			for e := v2.connIdList.Back(); e != nil; e = e.Prev() {
-				connIdList = append(connIdList, e.Value.(int64))
-			}
+			_, connIdList := v2.sortedConns()
 			return v.authKey, connIdList
 		}
 	}
 
 	return nil, nil
 }
+
+// permSession is a session of an auth key bound to a perm key, salt is the last one
+// the client used on its best connection.
+type permSession struct {
+	authKey    *authKeyUtil
+	sessionId  int64
+	salt       int64
+	connIdList []int64
+}
+
+// FoundPermSessions returns every session of permAuthKeyId and of the temp keys bound to it.
+func (m *authSessionManager) FoundPermSessions(permAuthKeyId int64) []permSession {
+	m.rw.RLock()
+	defer m.rw.RUnlock()
+
+	var sessions []permSession
+	for kId, v := range m.sessions {
+		if kId != permAuthKeyId && v.authKey.PermAuthKeyId() != permAuthKeyId {
+			continue
+		}
+		for sessionId, v2 := range v.sessionList {
+			salt, connIdList := v2.sortedConns()
+			sessions = append(sessions, permSession{
+				authKey:    v.authKey,
+				sessionId:  sessionId,
+				salt:       salt,
+				connIdList: connIdList,
+			})
+		}
+	}
+
+	return sessions
+}
+
+// sortedConns returns the connections of the session best first and the salt of the best.
+func (v sessionData) sortedConns() (int64, []int64) {
+	conns := make([]*sessionConn, 0, len(v.conns))
+	for _, c := range v.conns {
+		conns = append(conns, c)
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].better(conns[j])
+	})
+
+	connIdList := make([]int64, 0, len(conns))
+	for _, c := range conns {
+		connIdList = append(connIdList, c.connId)
+	}
+	if len(conns) == 0 {
+		return 0, connIdList
+	}
+
+	return conns[0].salt.Load(), connIdList
+}
+
+// RemoveAuthKey drops every session of authKeyId, and of the temp keys bound to it
+// if authKeyId is a perm key. It returns the removed keys and their connections.
+func (m *authSessionManager) RemoveAuthKey(authKeyId int64) (keyIdList []int64, connIdList []int64) {
+	logx.Debugf("removeAuthKey: auth_key_id: %d", authKeyId)
+
+	m.rw.Lock()
+	defer m.rw.Unlock()
+
+	for kId, v := range m.sessions {
+		if kId != authKeyId && v.authKey.PermAuthKeyId() != authKeyId {
+			continue
+		}
+		for _, v2 := range v.sessionList {
+			for connId := range v2.conns {
+				connIdList = append(connIdList, connId)
+			}
+		}
+		delete(m.sessions, kId)
+		keyIdList = append(keyIdList, kId)
+	}
+
+	return
+}
+
+// ShardKeys returns the shard keys of all auth keys with a session here.
+func (m *authSessionManager) ShardKeys() []string {
+	m.rw.RLock()
+	defer m.rw.RUnlock()
+
+	var (
+		seen = make(map[string]struct{}, len(m.sessions))
+		keys = make([]string, 0, len(m.sessions))
+	)
+	for _, v := range m.sessions {
+		key := sessionclient.ShardKey(v.authKey.AuthKeyId(), v.authKey.PermAuthKeyId())
+		if _, ok := seen[key]; !ok {
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,173 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/panjf2000/gnet/v2"
+	"github.com/zeromicro/go-zero/core/contextx"
+)
+
+// deliveryItem is a payload for a session, authKey is nil if the session has no connection here.
+type deliveryItem struct {
+	authKey    *authKeyUtil
+	authKeyId  int64
+	sessionId  int64
+	payload    []byte
+	connIdList []int64
+
+	msg       []byte
+	droppable bool
+	receipts  []*gateway.DeliveryReceipt
+	done      bool
+}
+
+func (s *Server) newDeliveryItem(authKeyId, sessionId int64, payload []byte) *deliveryItem {
+	authKey, connIdList := s.authSessionMgr.FoundSessionConnId(authKeyId, sessionId)
+
+	return &deliveryItem{
+		authKey:    authKey,
+		authKeyId:  authKeyId,
+		sessionId:  sessionId,
+		payload:    payload,
+		connIdList: connIdList,
+	}
+}
+
+type itemReceipt struct {
+	idx     int
+	receipt *gateway.DeliveryReceipt
+}
+
+// deliverBatch encrypts every item and writes it to the best connection of its session,
+// with one trigger per connection for all the items going there. An item whose write
+// failed goes on to the next connection of its session like deliverToSession. It waits
+// up to timeout and returns the receipts of every item, in the order of items.
+func (s *Server) deliverBatch(ctx context.Context, items []*deliveryItem, timeout time.Duration) *gateway.Vector_GatewayDeliveryResult {
+	var (
+		wCtx    = contextx.ValueOnlyFrom(ctx)
+		byConn  = make(map[int64][]int)
+		pending = 0
+		n       = 0
+	)
+	for i, it := range items {
+		if len(it.connIdList) == 0 {
+			it.receipts = append(it.receipts, gateway.NewDeliveryReceipt(0, gateway.DeliveryNoConnection))
+			it.done = true
+			continue
+		}
+
+		it.msg = encryptToClient(it.authKey, it.payload)
+		it.droppable = droppableServerMessage(it.payload)
+		byConn[it.connIdList[0]] = append(byConn[it.connIdList[0]], i)
+		pending++
+		n += len(it.connIdList)
+	}
+
+	results := make(chan itemReceipt, n) // never blocks the event loop
+	for connId, idxList := range byConn {
+		connId, idxList := connId, idxList
+		s.eng.Trigger(connId, func(c gnet.Conn) {
+			for _, i := range idxList {
+				i, it := i, items[i]
+				status := s.writeToConn(wCtx, c, it.authKeyId, it.msg, it.droppable)
+				results <- itemReceipt{idx: i, receipt: gateway.NewDeliveryReceipt(connId, status)}
+				if status != gateway.DeliveryWritten {
+					s.deliverToSession(wCtx, it.authKeyId, it.msg, it.droppable, it.connIdList[1:], func(connId2 int64, status2 int32) {
+						results <- itemReceipt{idx: i, receipt: gateway.NewDeliveryReceipt(connId2, status2)}
+					})
+				}
+			}
+		})
+	}
+
+	if pending > 0 {
+		s.waitReceipts(ctx, items, results, pending, timeout)
+	}
+
+	reply := &gateway.Vector_GatewayDeliveryResult{
+		Datas: make([]*gateway.GatewayDeliveryResult, 0, len(items)),
+	}
+	for _, it := range items {
+		reply.Datas = append(reply.Datas, gateway.MakeTLGatewayDeliveryResult(&gateway.GatewayDeliveryResult{
+			Constructor: gateway.CRC32_gatewayDeliveryResult,
+			AuthKeyId:   it.authKeyId,
+			SessionId:   it.sessionId,
+			Receipts:    it.receipts,
+		}).To_GatewayDeliveryResult())
+	}
+
+	return reply
+}
+
+// waitReceipts collects the receipts of items until every item was written or ran out of
+// connections, or timeout. An item still waiting then gets timeout on the connection
+// it waits for.
+func (s *Server) waitReceipts(ctx context.Context, items []*deliveryItem, results <-chan itemReceipt, pending int, timeout time.Duration) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for pending > 0 {
+		select {
+		case r := <-results:
+			it := items[r.idx]
+			if it.done {
+				continue
+			}
+			it.receipts = append(it.receipts, r.receipt)
+			if r.receipt.Status == gateway.DeliveryWritten || len(it.receipts) == len(it.connIdList) {
+				it.done = true
+				pending--
+			}
+			continue
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+		break
+	}
+
+	for _, it := range items {
+		if it.done {
+			continue
+		}
+
+		// gnet drops the write of a connection that closed meanwhile without calling us
+		// back, a connection that is gone by now is no_connection rather than timeout.
+		connId, status := it.connIdList[len(it.receipts)], gateway.DeliveryNoConnection
+		_, connIdList := s.authSessionMgr.FoundSessionConnId(it.authKeyId, it.sessionId)
+		for _, id := range connIdList {
+			if id == connId {
+				status = gateway.DeliveryTimeout
+				break
+			}
+		}
+		it.receipts = append(it.receipts, gateway.NewDeliveryReceipt(connId, status))
+		it.done = true
+	}
+}
+
+// deliveryTimeout is the timeout of a request in ms, or the default.
+func deliveryTimeout(timeout int32) time.Duration {
+	if timeout <= 0 {
+		timeout = gateway.DefaultDeliveryTimeout
+	}
+
+	return time.Duration(timeout) * time.Millisecond
+}
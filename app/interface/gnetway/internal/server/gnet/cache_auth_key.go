@@ -16,41 +16,51 @@
 package gnet
 
 import (
-	"fmt"
+	"time"
 
 	"github.com/teamgram/proto/mtproto"
 )
 
 type CacheV struct {
 	V *mtproto.AuthKeyInfo
+	// ExpiresAt is the unix time a temp or media-temp key stops being valid, 0 means never.
+	ExpiresAt int64
 }
 
-func (c CacheV) Size() int {
-	return 1
+func (c CacheV) Expired(now int64) bool {
+	return c.ExpiresAt > 0 && now >= c.ExpiresAt
 }
 
-func (s *Server) GetAuthKey(authKeyId int64) *mtproto.AuthKeyInfo {
-	var (
-		cacheK = fmt.Sprintf("%d", authKeyId)
-		value  *CacheV
-	)
-
-	if v, ok := s.cache.Get(cacheK); ok {
-		value = v.(*CacheV)
+// calcAuthKeyExpiresAt converts the expires_in of p_q_inner_data_temp(_dc) to an absolute time.
+// Only temp and media-temp keys expire.
+func calcAuthKeyExpiresAt(keyType int32, expiresIn int32) int64 {
+	if keyType == mtproto.AuthKeyTypePerm || expiresIn <= 0 {
+		return 0
 	}
 
-	if value == nil {
-		return nil
-	} else {
-		return value.V
-	}
+	return time.Now().Unix() + int64(expiresIn)
 }
 
-func (s *Server) PutAuthKey(keyInfo *mtproto.AuthKeyInfo) {
-	var (
-		cacheK = fmt.Sprintf("%d", keyInfo.AuthKeyId)
-	)
+// GetAuthKey looks authKeyId up in the cache, ok is false on a miss.
+// A hit with a nil V means the session service doesn't know the key.
+func (s *Server) GetAuthKey(authKeyId int64) (v CacheV, ok bool) {
+	return s.authKeyCache.Get(authKeyId)
+}
+
+func (s *Server) PutAuthKey(keyInfo *mtproto.AuthKeyInfo, expiresAt int64) {
+	s.authKeyCache.Put(keyInfo, expiresAt)
+}
+
+func (s *Server) PutUnknownAuthKey(authKeyId int64) {
+	s.authKeyCache.PutNegative(authKeyId)
+}
+
+// RemoveAuthKey drops authKeyId from the cache, the next frame queries the session again.
+func (s *Server) RemoveAuthKey(authKeyId int64) bool {
+	return s.authKeyCache.Remove(authKeyId)
+}
 
-	// TODO: expires_in
-	s.cache.Set(cacheK, &CacheV{V: keyInfo})
+// logAuthKeyCacheStat logs the lookups of the last minute.
+func (s *Server) logAuthKeyCacheStat() {
+	s.authKeyCache.logStat()
 }
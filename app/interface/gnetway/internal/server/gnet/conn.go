@@ -46,6 +46,14 @@ type connContext struct {
 	newSession bool
 	nextSeqNo  int32
 	closeDate  int64
+	idleAt     int64 // the deadline in the idle wheel, 0 if none
+	// disconnectAt is when the client asked to be disconnected with ping_delay_disconnect
+	disconnectAt int64
+	dispatcher   *connDispatcher
+	// resolving counts the frames queued on dispatcher until their auth key is known,
+	// the frames after them queue too to keep the order
+	resolving int
+	outbound  outboundQueue
 }
 
 func newConnContext() *connContext {
@@ -0,0 +1,114 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"sync"
+)
+
+const (
+	defaultMaxPending = 256
+)
+
+// connDispatcher hands the frames of one connection to the session in the order they
+// were received. The tasks of a connection run one at a time on the shared pool,
+// different connections still run in parallel.
+type connDispatcher struct {
+	mu       sync.Mutex
+	tasks    []func()
+	running  bool
+	paused   bool
+	high     int
+	low      int
+	submit   func(task func()) error
+	onResume func()
+}
+
+func newConnDispatcher(maxPending int, submit func(task func()) error, onResume func()) *connDispatcher {
+	if maxPending <= 0 {
+		maxPending = defaultMaxPending
+	}
+
+	return &connDispatcher{
+		high:     maxPending,
+		low:      maxPending / 2,
+		submit:   submit,
+		onResume: onResume,
+	}
+}
+
+// Dispatch queues task behind the pending tasks of the connection.
+func (d *connDispatcher) Dispatch(task func()) {
+	d.mu.Lock()
+	d.tasks = append(d.tasks, task)
+	if d.running {
+		d.mu.Unlock()
+		return
+	}
+	d.running = true
+	d.mu.Unlock()
+
+	if err := d.submit(d.run); err != nil {
+		// the pool is overloaded, don't lose the order
+		go d.run()
+	}
+}
+
+// Overloaded reports whether the connection should stop decoding, onResume is
+// called once the session has caught up.
+func (d *connDispatcher) Overloaded() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.tasks) >= d.high {
+		d.paused = true
+	}
+	return d.paused
+}
+
+func (d *connDispatcher) Pending() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return len(d.tasks)
+}
+
+func (d *connDispatcher) run() {
+	for {
+		d.mu.Lock()
+		if len(d.tasks) == 0 {
+			d.tasks = nil
+			d.running = false
+			d.mu.Unlock()
+			return
+		}
+
+		task := d.tasks[0]
+		d.tasks[0] = nil
+		d.tasks = d.tasks[1:]
+
+		resume := d.paused && len(d.tasks) <= d.low
+		if resume {
+			d.paused = false
+		}
+		d.mu.Unlock()
+
+		if resume {
+			d.onResume()
+		}
+		task()
+	}
+}
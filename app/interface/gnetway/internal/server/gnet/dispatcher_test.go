@@ -0,0 +1,223 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/gnet/ws"
+
+	"github.com/panjf2000/gnet/v2"
+)
+
+// manualPool runs the submitted tasks when the test says so.
+type manualPool struct {
+	mu    sync.Mutex
+	tasks []func()
+}
+
+func (p *manualPool) submit(task func()) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.tasks = append(p.tasks, task)
+	return nil
+}
+
+func (p *manualPool) runAll() {
+	for {
+		p.mu.Lock()
+		if len(p.tasks) == 0 {
+			p.mu.Unlock()
+			return
+		}
+		task := p.tasks[0]
+		p.tasks = p.tasks[1:]
+		p.mu.Unlock()
+
+		task()
+	}
+}
+
+func TestConnDispatcherOrder(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		got  []int
+		done = make(chan struct{})
+		n    = 1000
+		pool = func(task func()) error {
+			go task()
+			return nil
+		}
+	)
+
+	d := newConnDispatcher(n, pool, func() {})
+	for i := 0; i < n; i++ {
+		i := i
+		d.Dispatch(func() {
+			mu.Lock()
+			got = append(got, i)
+			if len(got) == n {
+				close(done)
+			}
+			mu.Unlock()
+		})
+	}
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("only %d of %d tasks ran", len(got), n)
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("task %d ran at %d", v, i)
+		}
+	}
+}
+
+func TestConnDispatcherSubmitError(t *testing.T) {
+	var (
+		got  = make(chan int, 2)
+		pool = func(task func()) error {
+			return errors.New("overloaded")
+		}
+	)
+
+	d := newConnDispatcher(0, pool, func() {})
+	d.Dispatch(func() { got <- 1 })
+	d.Dispatch(func() { got <- 2 })
+
+	for want := 1; want <= 2; want++ {
+		select {
+		case v := <-got:
+			if v != want {
+				t.Fatalf("got task %d, want %d", v, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("task %d didn't run without the pool", want)
+		}
+	}
+}
+
+func TestConnDispatcherPending(t *testing.T) {
+	var (
+		pool    manualPool
+		resumed int
+	)
+
+	d := newConnDispatcher(4, pool.submit, func() { resumed++ })
+	cases := []struct {
+		name        string
+		dispatch    int
+		wantPending int
+		overloaded  bool
+	}{
+		{"empty", 0, 0, false},
+		{"below high", 3, 3, false},
+		{"at high", 1, 4, true},
+		{"above high", 2, 6, true},
+	}
+
+	for _, tc := range cases {
+		for i := 0; i < tc.dispatch; i++ {
+			d.Dispatch(func() {})
+		}
+		if p := d.Pending(); p != tc.wantPending {
+			t.Errorf("%s: Pending() = %d, want %d", tc.name, p, tc.wantPending)
+		}
+		if o := d.Overloaded(); o != tc.overloaded {
+			t.Errorf("%s: Overloaded() = %v, want %v", tc.name, o, tc.overloaded)
+		}
+	}
+
+	// only one run is submitted for the whole queue
+	if len(pool.tasks) != 1 {
+		t.Fatalf("%d runs submitted, want 1", len(pool.tasks))
+	}
+
+	pool.runAll()
+	if p := d.Pending(); p != 0 {
+		t.Errorf("Pending() = %d after the run, want 0", p)
+	}
+	if d.Overloaded() {
+		t.Errorf("Overloaded() after the run")
+	}
+	if resumed != 1 {
+		t.Errorf("resumed %d times, want 1", resumed)
+	}
+}
+
+// inboundConn is a gnet.Conn with only InboundBuffered, what paused looks at.
+type inboundConn struct {
+	gnet.Conn
+	inbound int
+}
+
+func (c *inboundConn) InboundBuffered() int { return c.inbound }
+func (c *inboundConn) String() string       { return "test" }
+
+func TestServerPaused(t *testing.T) {
+	s := &Server{
+		c: &config.Config{
+			Gnetway: &config.GnetwayConfig{
+				Dispatch: config.DispatchConfig{MaxPending: 2, MaxInboundBytes: 1024},
+			},
+		},
+	}
+
+	cases := []struct {
+		name       string
+		pending    int
+		inbound    int
+		wsInbound  int // held by the websocket codec, decoded or not
+		wantPaused bool
+		wantAction gnet.Action
+	}{
+		{"below max pending", 1, 4096, 0, false, gnet.None},
+		{"at max pending", 2, 0, 0, true, gnet.None},
+		{"at max inbound bytes", 2, 1024, 0, true, gnet.None},
+		{"past max inbound bytes", 2, 1025, 0, true, gnet.Close},
+		{"websocket at max inbound bytes", 2, 512, 512, true, gnet.None},
+		{"websocket past max inbound bytes", 2, 0, 1025, true, gnet.Close},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var pool manualPool
+			ctx := &connContext{
+				dispatcher: newConnDispatcher(s.c.Gnetway.Dispatch.MaxPending, pool.submit, func() {}),
+			}
+			for i := 0; i < tc.pending; i++ {
+				ctx.dispatcher.Dispatch(func() {})
+			}
+			if tc.wsInbound > 0 {
+				ctx.websocket, ctx.wsCodec = true, &ws.WsCodec{}
+				ctx.wsCodec.Buf.Write(make([]byte, tc.wsInbound/2))
+				_, _ = ctx.wsCodec.Conn.InboundBuffer.Write(make([]byte, tc.wsInbound-tc.wsInbound/2))
+			}
+
+			paused, action := s.paused(ctx, &inboundConn{inbound: tc.inbound})
+			if paused != tc.wantPaused || action != tc.wantAction {
+				t.Fatalf("paused() = %v, %v, want %v, %v", paused, action, tc.wantPaused, tc.wantAction)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"sync"
+	"time"
+
+	"github.com/panjf2000/gnet/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// drainCollectTimeout is how long a drain waits for the event loops to list their connections.
+	drainCollectTimeout = time.Second
+	drainPollDelay      = 10 * time.Millisecond
+)
+
+// drainState is the drain of a gnetway, there is one at most over its life.
+type drainState struct {
+	once sync.Once
+	done chan struct{}
+}
+
+// Draining reports whether the gnetway is draining, it takes no new connection then.
+func (s *Server) Draining() bool {
+	return s.draining.Load()
+}
+
+// Drain stops taking new connections and closes the open ones spread over window, so
+// their clients don't all reconnect at once. The idle connections are closed first, a
+// busy one after what it has to write is written or at the end of window. The session
+// hears of each close with its closeSession, as it happens.
+//
+// Drain returns when the connections are closed, or window is over, a window of 0 is
+// Gnetway.Drain.Window. It drains once, a later call waits for the first one whatever
+// its window.
+func (s *Server) Drain(window time.Duration) {
+	if window <= 0 {
+		window = s.c.Gnetway.Drain.Window
+	}
+
+	s.drain.once.Do(func() {
+		// GatewayDrainGateway sets it first, Store covers the other callers
+		s.draining.Store(true)
+		go func() {
+			defer close(s.drain.done)
+			s.drainConns(window)
+		}()
+	})
+
+	<-s.drain.done
+}
+
+func (s *Server) drainConns(window time.Duration) {
+	var (
+		start    = time.Now()
+		deadline = start.Add(window)
+	)
+
+	idle, busy := s.collectDrainConns()
+	logx.Infof("drain %d idle and %d busy connections in %s", len(idle), len(busy), window)
+
+	connIdList := append(idle, busy...)
+	for i, connId := range connIdList {
+		if at := start.Add(window * time.Duration(i) / time.Duration(len(connIdList))); time.Now().Before(at) {
+			time.Sleep(time.Until(at))
+		}
+		s.closeWhenDone(connId, deadline)
+	}
+
+	for time.Now().Before(deadline) && s.eng.CountConnections() > 0 {
+		time.Sleep(drainPollDelay)
+	}
+
+	logx.Infof("drained in %s, %d connections left", time.Since(start), s.eng.CountConnections())
+}
+
+// collectDrainConns lists the open connections on their event loops, the idle ones apart
+// from the busy ones.
+func (s *Server) collectDrainConns() (idle, busy []int64) {
+	var (
+		mu       sync.Mutex
+		expected = s.eng.CountConnections()
+	)
+
+	s.eng.Iterate(func(c gnet.Conn) {
+		ctx, _ := c.Context().(*connContext)
+		if ctx == nil {
+			return
+		}
+
+		mu.Lock()
+		if connBusy(c, ctx) {
+			busy = append(busy, c.ConnId())
+		} else {
+			idle = append(idle, c.ConnId())
+		}
+		mu.Unlock()
+	})
+
+	// a connection closed meanwhile is not listed, don't wait for it too long
+	for timeout := time.Now().Add(drainCollectTimeout); time.Now().Before(timeout); {
+		mu.Lock()
+		n := len(idle) + len(busy)
+		mu.Unlock()
+		if n >= expected {
+			break
+		}
+		time.Sleep(drainPollDelay)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	return append([]int64(nil), idle...), append([]int64(nil), busy...)
+}
+
+// connBusy reports whether c has something to write yet, or frames the session didn't take.
+func connBusy(c gnet.Conn, ctx *connContext) bool {
+	return ctx.outbound.pending(c) > 0 || ctx.dispatcher.Pending() > 0
+}
+
+// closeWhenDone closes connId once it is not busy, or at deadline.
+func (s *Server) closeWhenDone(connId int64, deadline time.Time) {
+	s.eng.Trigger(connId, func(c gnet.Conn) {
+		ctx, _ := c.Context().(*connContext)
+		if ctx == nil {
+			return
+		}
+
+		if connBusy(c, ctx) {
+			if wait := time.Until(deadline); wait > 0 {
+				if wait > outboundRetryDelay {
+					wait = outboundRetryDelay
+				}
+				time.AfterFunc(wait, func() {
+					s.closeWhenDone(connId, deadline)
+				})
+				return
+			}
+			logx.Infof("conn(%s) still busy at the end of the drain, close it", c)
+		}
+
+		logx.Debugf("conn(%s) drained", c)
+		_ = c.Close()
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GatewayBroadcastDataToGateway
+// gateway.broadcastDataToGateway perm_auth_key_id:long body:bytes timeout:int = Vector<GatewayDeliveryResult>;
+//
+// body is a message without its envelope, the gnetway frames it for every session of
+// perm_auth_key_id and of the temp keys bound to it, and returns a result per session.
+// It frames it with seqno 0, out of the seqno and the acks the session keeps, so body
+// must not be content related, e.g. a msgs_ack or a msgs_state_info. The session builds
+// the envelopes of the rest itself and sends them with gateway.sendBatchDataToGateway.
+func (s *Server) GatewayBroadcastDataToGateway(ctx context.Context, in *gateway.TLGatewayBroadcastDataToGateway) (reply *gateway.Vector_GatewayDeliveryResult, err error) {
+	logx.WithContext(ctx).Infof("BroadcastData - request: {permKId: %d, bodyLen: %d, timeout: %d}", in.PermAuthKeyId, len(in.Body), in.Timeout)
+
+	if contentRelated(in.Body) {
+		logx.WithContext(ctx).Errorf("BroadcastData - content related body of perm_auth_key_id(%d) refused", in.PermAuthKeyId)
+		return nil, mtproto.ErrInputConstructorInvalid
+	}
+
+	sessions := s.authSessionMgr.FoundPermSessions(in.PermAuthKeyId)
+	items := make([]*deliveryItem, 0, len(sessions))
+	for _, v := range sessions {
+		items = append(items, &deliveryItem{
+			authKey:    v.authKey,
+			authKeyId:  v.authKey.AuthKeyId(),
+			sessionId:  v.sessionId,
+			payload:    serializeRawToBuffer(v.salt, v.sessionId, in.Body),
+			connIdList: v.connIdList,
+		})
+	}
+
+	return s.deliverBatch(ctx, items, deliveryTimeout(in.Timeout)), nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GatewayDrainGateway
+// gateway.drainGateway window:int = Bool;
+//
+// window is in seconds, 0 is Gnetway.Drain.Window. It returns once the drain started,
+// BoolFalse if it was started before.
+func (s *Server) GatewayDrainGateway(ctx context.Context, in *gateway.TLGatewayDrainGateway) (reply *mtproto.Bool, err error) {
+	logx.WithContext(ctx).Infof("DrainGateway - request: {window: %d}", in.Window)
+
+	if in.Window < 0 {
+		return mtproto.BoolFalse, nil
+	}
+	// set here, not by Drain in its goroutine, so a call right after this one sees it
+	if !s.draining.CompareAndSwap(false, true) {
+		logx.WithContext(ctx).Infof("DrainGateway - draining already")
+		return mtproto.BoolFalse, nil
+	}
+
+	go s.Drain(time.Duration(in.Window) * time.Second)
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/panjf2000/gnet/v2"
+	"github.com/zeromicro/go-zero/core/contextx"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// invalidateCloseWait is how long a connection of an auth key logged out may take to
+// write what it has, the result of auth.logOut among it.
+const invalidateCloseWait = 5 * time.Second
+
+// GatewayInvalidateAuthKey
+// gateway.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (s *Server) GatewayInvalidateAuthKey(ctx context.Context, in *gateway.TLGatewayInvalidateAuthKey) (reply *mtproto.Bool, err error) {
+	logx.WithContext(ctx).Infof("InvalidateAuthKey - request: {kId: %d, destroyed: %v}", in.AuthKeyId, in.Destroyed)
+
+	keyIdList, _ := s.authSessionMgr.RemoveAuthKey(in.AuthKeyId)
+	// the temp keys bound to it without a session here are only cached
+	keyIdList = append(keyIdList, s.authKeyCache.RemoveBound(in.AuthKeyId)...)
+
+	keyIds := map[int64]struct{}{in.AuthKeyId: {}}
+	s.RemoveAuthKey(in.AuthKeyId)
+	for _, keyId := range keyIdList {
+		keyIds[keyId] = struct{}{}
+		s.RemoveAuthKey(keyId)
+	}
+	if in.Destroyed {
+		// don't query the session again for a destroyed key
+		s.PutUnknownAuthKey(in.AuthKeyId)
+	}
+
+	// a connection may hold the key before it has a session, look at all of them
+	var (
+		deadline = time.Now().Add(invalidateCloseWait)
+		ctx2     = contextx.ValueOnlyFrom(ctx)
+	)
+	s.eng.Iterate(func(c gnet.Conn) {
+		connCtx, _ := c.Context().(*connContext)
+		if connCtx == nil {
+			return
+		}
+
+		authKey := connCtx.getAuthKey()
+		if authKey == nil {
+			return
+		}
+		if _, ok := keyIds[authKey.AuthKeyId()]; !ok && authKey.PermAuthKeyId() != in.AuthKeyId {
+			return
+		}
+
+		logx.WithContext(ctx2).Infof("close conn(%s) by auth_key(%d) invalidated", c, authKey.AuthKeyId())
+		if in.Destroyed {
+			s.sendTransportError(c, -404)
+			_ = c.Close()
+			return
+		}
+		s.closeWhenDone(c.ConnId(), deadline)
+	})
+
+	return mtproto.BoolTrue, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GatewaySendBatchDataToGateway
+// gateway.sendBatchDataToGateway items:Vector<GatewayData> timeout:int = Vector<GatewayDeliveryResult>;
+//
+// Every item goes like gateway.sendDataToGatewayWithReceipt, but in one pass over all of
+// them, the result of an item is at its index.
+func (s *Server) GatewaySendBatchDataToGateway(ctx context.Context, in *gateway.TLGatewaySendBatchDataToGateway) (reply *gateway.Vector_GatewayDeliveryResult, err error) {
+	logx.WithContext(ctx).Infof("ReceiveBatchData - request: {items: %d, timeout: %d}", len(in.Items), in.Timeout)
+
+	items := make([]*deliveryItem, 0, len(in.Items))
+	for _, v := range in.Items {
+		items = append(items, s.newDeliveryItem(v.AuthKeyId, v.SessionId, v.Payload))
+	}
+
+	return s.deliverBatch(ctx, items, deliveryTimeout(in.Timeout)), nil
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// GatewaySendDataToGatewayWithReceipt
+// gateway.sendDataToGatewayWithReceipt auth_key_id:long session_id:long payload:bytes timeout:int = Vector<DeliveryReceipt>;
+//
+// Unlike gateway.sendDataToGateway it waits for the write, up to timeout ms, and returns
+// the outcome on every connection it tried, the best one first.
+func (s *Server) GatewaySendDataToGatewayWithReceipt(ctx context.Context, in *gateway.TLGatewaySendDataToGatewayWithReceipt) (reply *gateway.Vector_DeliveryReceipt, err error) {
+	logx.WithContext(ctx).Infof("ReceiveData - request: {kId: %d, sessionId: %d, payloadLen: %d, timeout: %d}", in.AuthKeyId, in.SessionId, len(in.Payload), in.Timeout)
+
+	it := s.newDeliveryItem(in.AuthKeyId, in.SessionId, in.Payload)
+	if len(it.connIdList) == 0 {
+		logx.WithContext(ctx).Errorf("ReceiveData - not found connId - keyId: %d, sessionId: %d", in.AuthKeyId, in.SessionId)
+	}
+
+	r := s.deliverBatch(ctx, []*deliveryItem{it}, deliveryTimeout(in.Timeout))
+
+	return &gateway.Vector_DeliveryReceipt{
+		Datas: r.Datas[0].Receipts,
+	}, nil
+}
@@ -40,36 +40,75 @@ func (s *Server) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGat
 	}
 
 	ctx = contextx.ValueOnlyFrom(ctx)
-	msgKey, mtpRawData, _ := authKey.AesIgeEncrypt(in.Payload)
-	x := mtproto.NewEncodeBuf(8 + len(msgKey) + len(mtpRawData))
-	x.Long(authKey.AuthKeyId())
-	x.Bytes(msgKey)
-	x.Bytes(mtpRawData)
-	msg := x.GetBuf()
+	msg := encryptToClient(authKey, in.Payload)
+	droppable := droppableServerMessage(in.Payload)
 
 	_ = s.pool.Submit(func() {
-		for _, connId := range connIdList {
-			s.eng.Trigger(connId, func(c gnet.Conn) {
-				connCtx, _ := c.Context().(*connContext)
-				if connCtx == nil {
-					logx.WithContext(ctx).Errorf("invalid state - conn(%s) Context() is nil", c)
-					return
-				}
-
-				if in.AuthKeyId != connCtx.getAuthKey().AuthKeyId() {
-					logx.WithContext(ctx).Errorf("invalid state - conn(%s) c.keyId(%d) != in.keyId(%d) is nil", authKey.AuthKeyId(), in.AuthKeyId)
-					return
-				}
-
-				err2 := UnThreadSafeWrite(c, msg)
-				if err2 != nil {
-					logx.WithContext(ctx).Errorf("sendToClient error: %v", err2)
-				} else {
-					logx.WithContext(ctx).Debugf("sendToConn: %v", connId)
-				}
-			})
-		}
+		s.deliverToSession(ctx, in.AuthKeyId, msg, droppable, connIdList, func(connId int64, status int32) {
+			if status == gateway.DeliveryWritten {
+				logx.WithContext(ctx).Debugf("sendToConn: %v", connId)
+			}
+		})
 	})
 
 	return mtproto.BoolTrue, nil
 }
+
+// deliverToSession writes msg to the first of connIdList, the connections of a session
+// best first, and to the next one only if the write failed. droppable msg may be dropped
+// for a slow connection, see queueWrite. onReceipt gets the status of every connection
+// tried, on the event loop of the connection.
+//
+// gnet skips the callback of a connection that closed meanwhile, delivery stops there
+// rather than go on to the next one.
+func (s *Server) deliverToSession(ctx context.Context, authKeyId int64, msg []byte, droppable bool, connIdList []int64, onReceipt func(connId int64, status int32)) {
+	var try func(i int)
+	try = func(i int) {
+		if i >= len(connIdList) {
+			return
+		}
+
+		connId := connIdList[i]
+		s.eng.Trigger(connId, func(c gnet.Conn) {
+			status := s.writeToConn(ctx, c, authKeyId, msg, droppable)
+			onReceipt(connId, status)
+			if status != gateway.DeliveryWritten {
+				try(i + 1)
+			}
+		})
+	}
+
+	try(0)
+}
+
+// writeToConn queues msg of authKeyId for c on its event loop and returns the status of the write.
+func (s *Server) writeToConn(ctx context.Context, c gnet.Conn, authKeyId int64, msg []byte, droppable bool) int32 {
+	connCtx, _ := c.Context().(*connContext)
+	if connCtx == nil {
+		logx.WithContext(ctx).Errorf("invalid state - conn(%s) Context() is nil", c)
+		return gateway.DeliveryNoConnection
+	}
+
+	if k := connCtx.getAuthKey(); k == nil || k.AuthKeyId() != authKeyId {
+		logx.WithContext(ctx).Errorf("invalid state - conn(%s) c.keyId != in.keyId(%d)", c, authKeyId)
+		return gateway.DeliveryKeyMismatch
+	}
+
+	if err := s.queueWrite(c, connCtx, msg, droppable); err != nil {
+		logx.WithContext(ctx).Errorf("sendToClient error: %v", err)
+		return gateway.DeliveryWriteError
+	}
+
+	return gateway.DeliveryWritten
+}
+
+// encryptToClient encrypts payload with authKey into what a client connection expects.
+func encryptToClient(authKey *authKeyUtil, payload []byte) []byte {
+	msgKey, mtpRawData, _ := authKey.AesIgeEncrypt(payload)
+	x := mtproto.NewEncodeBuf(8 + len(msgKey) + len(mtpRawData))
+	x.Long(authKey.AuthKeyId())
+	x.Bytes(msgKey)
+	x.Bytes(mtpRawData)
+
+	return x.GetBuf()
+}
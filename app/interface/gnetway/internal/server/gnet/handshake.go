@@ -841,7 +841,7 @@ func (s *Server) onReqDHParams(c gnet.Conn, ctx *HandshakeStateCtx, request *mt.
 			x := bin.NewEncoder()
 			x.End()
 			_ = encodeUnencryptedMessage(x, mtproto.GenerateMessageId(), serverDHParams)
-			_ = UnThreadSafeWrite(c, x.Bytes())
+			_ = s.UnThreadSafeWrite(c, x.Bytes())
 			//
 			//x := mtproto.NewEncodeBuf(512)
 			//_ = serializeToBuffer(x, mtproto.GenerateMessageId(), serverDHParams)
@@ -1011,7 +1011,7 @@ func (s *Server) onSetClientDHParams(c gnet.Conn, ctx *HandshakeStateCtx, reques
 			x := bin.NewEncoder()
 			x.End()
 			_ = encodeUnencryptedMessage(x, mtproto.GenerateMessageId(), dhGen)
-			_ = UnThreadSafeWrite(c, x.Bytes())
+			_ = s.UnThreadSafeWrite(c, x.Bytes())
 		})
 
 	return nil, nil
@@ -1065,8 +1065,9 @@ func (s *Server) saveAuthKeyInfo(ctx *HandshakeStateCtx, key *mtproto.AuthKeyInf
 	var (
 		rB *mtproto.Bool
 	)
-	err := s.svcCtx.Dao.ShardingSessionClient.InvokeByKey(
-		strconv.FormatInt(key.AuthKeyId, 10),
+	err := s.svcCtx.Dao.ShardingSessionClient.InvokeByAuthKey(
+		key.AuthKeyId,
+		key.PermAuthKeyId,
 		func(client sessionclient.SessionClient) (err error) {
 			rB, err = client.SessionSetAuthKey(context.Background(), &session.TLSessionSetAuthKey{
 				AuthKey:    keyInfo,
@@ -1088,7 +1089,8 @@ func (s *Server) saveAuthKeyInfo(ctx *HandshakeStateCtx, key *mtproto.AuthKeyInf
 			AuthKeyType:        keyInfo.AuthKeyType,
 			PermAuthKeyId:      keyInfo.PermAuthKeyId,
 			TempAuthKeyId:      keyInfo.TempAuthKeyId,
-			MediaTempAuthKeyId: keyInfo.MediaTempAuthKeyId})
+			MediaTempAuthKeyId: keyInfo.MediaTempAuthKeyId},
+			calcAuthKeyExpiresAt(keyInfo.AuthKeyType, ctx.ExpiresIn))
 	}
 	return true
 }
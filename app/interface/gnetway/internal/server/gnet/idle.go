@@ -0,0 +1,107 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/panjf2000/gnet/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxDisconnectDelay caps the disconnect_delay of ping_delay_disconnect, in seconds.
+const maxDisconnectDelay = 3600
+
+// idleTimeout is how long the connection of ctx may stay silent, in seconds.
+func (s *Server) idleTimeout(ctx *connContext) int64 {
+	var (
+		idle    = s.c.Gnetway.Idle
+		timeout time.Duration
+	)
+
+	switch {
+	case ctx.getAuthKey() == nil:
+		return int64(idle.PreHandshake / time.Second)
+	case ctx.tcp:
+		timeout = idle.PostHandshake
+	default:
+		timeout = idle.Http
+	}
+	if jitter := int64(idle.Jitter / time.Second); jitter > 0 {
+		return int64(timeout/time.Second) + rand.Int63n(jitter)
+	}
+
+	return int64(timeout / time.Second)
+}
+
+// touchIdle pushes the close date of c back after it showed some life, but for a client
+// that set it with ping_delay_disconnect.
+func (s *Server) touchIdle(c gnet.Conn, ctx *connContext) {
+	if ctx.disconnectAt > 0 {
+		ctx.closeDate = ctx.disconnectAt
+	} else {
+		ctx.closeDate = time.Now().Unix() + s.idleTimeout(ctx)
+	}
+	s.scheduleIdle(c.ConnId(), ctx)
+}
+
+// scheduleIdle makes the idle wheel check c when it is to be closed or its auth key
+// expires. The wheel is only moved for an earlier deadline, a later one is found out
+// when the earlier is reached, traffic costs no more than setting closeDate.
+func (s *Server) scheduleIdle(connId int64, ctx *connContext) {
+	deadline := ctx.closeDate
+	if authKey := ctx.getAuthKey(); authKey != nil && authKey.ExpiresAt() > 0 && authKey.ExpiresAt() < deadline {
+		deadline = authKey.ExpiresAt()
+	}
+
+	if ctx.idleAt == 0 || deadline < ctx.idleAt {
+		ctx.idleAt = deadline
+		s.idleWheel.Set(connId, deadline)
+	}
+}
+
+// closeIdleConns checks the connections whose deadline is reached by now.
+func (s *Server) closeIdleConns(now int64) {
+	for _, connId := range s.idleWheel.Advance(now) {
+		s.eng.Trigger(connId, s.onIdleDeadline)
+	}
+}
+
+// onIdleDeadline closes c if it was silent for too long or its auth key expired,
+// or checks it again later.
+func (s *Server) onIdleDeadline(c gnet.Conn) {
+	ctx, _ := c.Context().(*connContext)
+	if ctx == nil {
+		return
+	}
+
+	now := time.Now().Unix()
+	ctx.idleAt = 0
+	if now >= ctx.closeDate {
+		logx.Debugf("close conn(%s) by timeout", c)
+		_ = c.Close()
+		return
+	}
+	if authKey := ctx.getAuthKey(); authKey != nil && authKey.Expired(now) {
+		logx.Infof("close conn(%s) by auth_key(%d) expired", c, authKey.AuthKeyId())
+		s.sendTransportError(c, -404)
+		_ = c.Close()
+		return
+	}
+
+	s.scheduleIdle(c.ConnId(), ctx)
+}
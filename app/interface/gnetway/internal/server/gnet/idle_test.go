@@ -0,0 +1,186 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/panjf2000/gnet/v2"
+)
+
+// idleConn is a gnet.Conn with only what onIdleDeadline looks at.
+type idleConn struct {
+	gnet.Conn
+	id     int64
+	ctx    *connContext
+	closed bool
+}
+
+func (c *idleConn) ConnId() int64        { return c.id }
+func (c *idleConn) Context() interface{} { return c.ctx }
+func (c *idleConn) Close() error         { c.closed = true; return nil }
+func (c *idleConn) String() string       { return "test" }
+
+func expiringAuthKey(expiresAt int64) *authKeyUtil {
+	return &authKeyUtil{keyData: &mtproto.AuthKeyInfo{AuthKeyId: 1}, expiresAt: expiresAt}
+}
+
+// wheelDeadline is when the wheel of s fires connId, 0 if it doesn't.
+func wheelDeadline(s *Server, connId int64) int64 {
+	if e, ok := s.idleWheel.entries[connId]; ok {
+		return e.deadline
+	}
+	return 0
+}
+
+func TestScheduleIdle(t *testing.T) {
+	now := time.Now().Unix()
+
+	cases := []struct {
+		name      string
+		idleAt    int64
+		closeDate int64
+		expiresAt int64
+		want      int64
+	}{
+		{"first deadline", 0, now + 100, 0, now + 100},
+		{"earlier deadline", now + 100, now + 30, 0, now + 30},
+		{"later deadline", now + 30, now + 500, 0, now + 30},
+		{"auth key expires first", 0, now + 500, now + 10, now + 10},
+		{"auth key expires later", 0, now + 500, now + 900, now + 500},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{idleWheel: newTimingWheel(now)}
+			ctx := &connContext{closeDate: tc.closeDate}
+			if tc.expiresAt > 0 {
+				ctx.putAuthKey(expiringAuthKey(tc.expiresAt))
+			}
+			if tc.idleAt > 0 {
+				ctx.idleAt = tc.idleAt
+				s.idleWheel.Set(1, tc.idleAt)
+			}
+
+			s.scheduleIdle(1, ctx)
+			if ctx.idleAt != tc.want {
+				t.Errorf("idleAt = %d, want %d", ctx.idleAt-now, tc.want-now)
+			}
+			if d := wheelDeadline(s, 1); d != tc.want {
+				t.Errorf("the wheel fires in %d, want %d", d-now, tc.want-now)
+			}
+		})
+	}
+}
+
+func TestOnIdleDeadline(t *testing.T) {
+	now := time.Now().Unix()
+
+	cases := []struct {
+		name       string
+		closeDate  int64
+		expiresAt  int64
+		wantClosed bool
+		wantAt     int64
+	}{
+		{"silent for too long", now - 1, 0, true, 0},
+		// touched since it was scheduled, the later close date is found out now
+		{"reschedule", now + 300, 0, false, now + 300},
+		{"auth key expired", now + 300, now - 1, true, 0},
+		{"reschedule for the auth key", now + 300, now + 60, false, now + 60},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Server{idleWheel: newTimingWheel(now)}
+			ctx := &connContext{closeDate: tc.closeDate, idleAt: now}
+			if tc.expiresAt != 0 {
+				ctx.putAuthKey(expiringAuthKey(tc.expiresAt))
+			}
+			c := &idleConn{id: 1, ctx: ctx}
+
+			s.onIdleDeadline(c)
+			if c.closed != tc.wantClosed {
+				t.Errorf("closed = %v, want %v", c.closed, tc.wantClosed)
+			}
+			if ctx.idleAt != tc.wantAt {
+				t.Errorf("idleAt = %d, want %d", ctx.idleAt, tc.wantAt)
+			}
+			if d := wheelDeadline(s, 1); d != tc.wantAt {
+				t.Errorf("the wheel fires at %d, want %d", d, tc.wantAt)
+			}
+		})
+	}
+}
+
+// TestTimingWheelCascade checks every deadline fires on its very tick, those of the
+// upper levels after cascading down, one or more times.
+func TestTimingWheelCascade(t *testing.T) {
+	const now = 1 << 30
+
+	cases := []struct {
+		id    int64
+		delta int64
+	}{
+		{1, 1},
+		{2, wheelSlots - 1},
+		{3, wheelSlots},     // level 1
+		{4, wheelSlots + 1}, // level 1, not on a slot boundary
+		{5, 100},
+		{6, wheelSlots * wheelSlots},     // level 2
+		{7, wheelSlots*wheelSlots + 777}, // level 2, cascades twice
+		{8, 300000},                      // level 3
+		{9, wheelSpan + 1000},            // capped to the span
+	}
+
+	w := newTimingWheel(now)
+	want := make(map[int64]int64)
+	for _, tc := range cases {
+		w.Set(tc.id, now+tc.delta)
+		want[now+tc.delta] = tc.id
+	}
+	want[now+wheelSpan] = 9
+	delete(want, now+wheelSpan+1000)
+
+	// moved to an earlier deadline across levels, and to a later one
+	w.Set(10, now+5000)
+	w.Set(10, now+3)
+	want[now+3] = 10
+	w.Set(11, now+2)
+	w.Set(11, now+70000)
+	want[now+70000] = 11
+
+	if n := w.Len(); n != len(want) {
+		t.Fatalf("Len() = %d, want %d", n, len(want))
+	}
+
+	for tick := int64(now + 1); tick <= now+wheelSpan; tick++ {
+		fired := w.Advance(tick)
+		id, ok := want[tick]
+		switch {
+		case ok && (len(fired) != 1 || fired[0] != id):
+			t.Fatalf("tick +%d fired %v, want [%d]", tick-now, fired, id)
+		case !ok && len(fired) != 0:
+			t.Fatalf("tick +%d fired %v, want none", tick-now, fired)
+		}
+	}
+	if n := w.Len(); n != 0 {
+		t.Fatalf("%d ids left in the wheel", n)
+	}
+}
@@ -168,6 +168,163 @@ func getRpcMethod(in mtproto.TLObject) mtproto.TLObject {
 	}
 }
 
+// serializeRawToBuffer frames body the way the session would, salt+session_id+msg_id+
+// seqno+len+body. The gnetway keeps no seqno of the session, seqno 0 is only valid for a
+// body that isn't content related, see contentRelated.
+func serializeRawToBuffer(salt, sessionId int64, body []byte) []byte {
+	x := mtproto.NewEncodeBuf(32 + len(body))
+
+	x.Long(salt)
+	x.Long(sessionId)
+	x.Long(nextMessageId(false))
+	x.Int(0)
+	x.Int(int32(len(body)))
+	x.Bytes(body)
+
+	return x.GetBuf()
+}
+
+// contentRelated tells whether body, a message without its envelope, is content related:
+// anything but the service messages a client doesn't acknowledge. Those need a seqno of
+// the session, only the session can frame them.
+func contentRelated(body []byte) bool {
+	if len(body) < 4 {
+		return true
+	}
+
+	switch mtproto.TLConstructor(binary.LittleEndian.Uint32(body)) {
+	case mtproto.CRC32_msgs_ack,
+		mtproto.CRC32_pong,
+		mtproto.CRC32_msgs_state_info,
+		mtproto.CRC32_msgs_all_info,
+		mtproto.CRC32_msg_detailed_info,
+		mtproto.CRC32_msg_new_detailed_info:
+		return false
+	}
+
+	return true
+}
+
+// sniffClientMessage tells from the constructors only what b, msg_id+seqno+len+body
+// as the client sent it, carries: keepAlive for ping_delay_disconnect, rpc for anything
+// but service messages. A msg_container is looked into, a gzip_packed one counts as rpc.
+func sniffClientMessage(b []byte) (keepAlive, rpc bool) {
+	if len(b) < 20 {
+		return
+	}
+
+	sniff := func(crc mtproto.TLConstructor) {
+		switch crc {
+		case mtproto.CRC32_ping_delay_disconnect:
+			keepAlive = true
+		case mtproto.CRC32_ping, mtproto.CRC32_msgs_ack, mtproto.CRC32_http_wait:
+		default:
+			rpc = true
+		}
+	}
+
+	body := b[16:]
+	if mtproto.TLConstructor(binary.LittleEndian.Uint32(body)) != mtproto.CRC32_msg_container {
+		sniff(mtproto.TLConstructor(binary.LittleEndian.Uint32(body)))
+		return
+	}
+
+	if len(body) < 8 {
+		return
+	}
+	n, off := int(binary.LittleEndian.Uint32(body[4:])), 8
+	for i := 0; i < n && off+20 <= len(body); i++ {
+		l := int(binary.LittleEndian.Uint32(body[off+12:]))
+		sniff(mtproto.TLConstructor(binary.LittleEndian.Uint32(body[off+16:])))
+		off += 16 + l
+	}
+
+	return
+}
+
+// sniffPingDelayDisconnect finds a ping_delay_disconnect in b, msg_id+seqno+len+body as the
+// client sent it, and returns the msg_id of its message, its ping_id and disconnect_delay.
+// A msg_container is looked into.
+func sniffPingDelayDisconnect(b []byte) (msgId, pingId int64, delay int32, ok bool) {
+	parse := func(m []byte) bool {
+		if len(m) < 32 || mtproto.TLConstructor(binary.LittleEndian.Uint32(m[16:])) != mtproto.CRC32_ping_delay_disconnect {
+			return false
+		}
+		msgId = int64(binary.LittleEndian.Uint64(m))
+		pingId = int64(binary.LittleEndian.Uint64(m[20:]))
+		delay = int32(binary.LittleEndian.Uint32(m[28:]))
+		return true
+	}
+
+	if len(b) < 20 {
+		return
+	}
+	body := b[16:]
+	if mtproto.TLConstructor(binary.LittleEndian.Uint32(body)) != mtproto.CRC32_msg_container {
+		ok = parse(b)
+		return
+	}
+
+	if len(body) < 8 {
+		return
+	}
+	n, off := int(binary.LittleEndian.Uint32(body[4:])), 8
+	for i := 0; i < n && off+16 <= len(body); i++ {
+		end := off + 16 + int(binary.LittleEndian.Uint32(body[off+12:]))
+		if end > len(body) {
+			break
+		}
+		if ok = parse(body[off:end]); ok {
+			return
+		}
+		off = end
+	}
+
+	return
+}
+
+// droppableServerMessage tells whether b, salt+session_id+msg_id+seqno+len+body as the
+// session sends it, carries updates only. The client gets a lost one back with
+// updates.getDifference, it is what a slow connection loses first.
+func droppableServerMessage(b []byte) bool {
+	if len(b) < 36 {
+		return false
+	}
+
+	isUpdates := func(crc mtproto.TLConstructor) bool {
+		switch crc {
+		case mtproto.CRC32_updates,
+			mtproto.CRC32_updatesCombined,
+			mtproto.CRC32_updateShort,
+			mtproto.CRC32_updateShortMessage,
+			mtproto.CRC32_updateShortChatMessage,
+			mtproto.CRC32_updatesTooLong:
+			return true
+		}
+		return false
+	}
+
+	body := b[32:]
+	if mtproto.TLConstructor(binary.LittleEndian.Uint32(body)) != mtproto.CRC32_msg_container {
+		return isUpdates(mtproto.TLConstructor(binary.LittleEndian.Uint32(body)))
+	}
+
+	if len(body) < 8 {
+		return false
+	}
+	n, off := int(binary.LittleEndian.Uint32(body[4:])), 8
+	for i := 0; i < n; i++ {
+		if off+20 > len(body) || !isUpdates(mtproto.TLConstructor(binary.LittleEndian.Uint32(body[off+16:]))) {
+			return false
+		}
+		if off += 16 + int(binary.LittleEndian.Uint32(body[off+12:])); off > len(body) {
+			return false
+		}
+	}
+
+	return n > 0
+}
+
 func tryGetUnknownTLObject(b []byte) (rList []mtproto.TLObject) {
 	var (
 		err  error
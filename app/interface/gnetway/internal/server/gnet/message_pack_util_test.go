@@ -0,0 +1,217 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// message is msg_id+seqno+len+body.
+func message(msgId int64, body []byte) []byte {
+	x := mtproto.NewEncodeBuf(16 + len(body))
+	x.Long(msgId)
+	x.Int(1)
+	x.Int(int32(len(body)))
+	x.Bytes(body)
+	return x.GetBuf()
+}
+
+// container is the body of a msg_container of msgs.
+func container(msgs ...[]byte) []byte {
+	x := mtproto.NewEncodeBuf(512)
+	x.Int(int32(mtproto.CRC32_msg_container))
+	x.Int(int32(len(msgs)))
+	for _, m := range msgs {
+		x.Bytes(m)
+	}
+	return x.GetBuf()
+}
+
+// constructor is a body of crc, followed by fields of n zero bytes.
+func constructor(crc mtproto.TLConstructor, n int) []byte {
+	b := make([]byte, 4+n)
+	binary.LittleEndian.PutUint32(b, uint32(crc))
+	return b
+}
+
+func pingDelayDisconnect(pingId int64, delay int32) []byte {
+	x := mtproto.NewEncodeBuf(16)
+	x.Int(int32(mtproto.CRC32_ping_delay_disconnect))
+	x.Long(pingId)
+	x.Int(delay)
+	return x.GetBuf()
+}
+
+// withCount sets the number of messages container claims to hold.
+func withCount(container []byte, n uint32) []byte {
+	b := append([]byte(nil), container...)
+	binary.LittleEndian.PutUint32(b[4:], n)
+	return b
+}
+
+// withLen sets the len of the message at off in b.
+func withLen(b []byte, off int, l uint32) []byte {
+	b = append([]byte(nil), b...)
+	binary.LittleEndian.PutUint32(b[off+12:], l)
+	return b
+}
+
+func serverMessage(body []byte) []byte {
+	return append(make([]byte, 16), message(5, body)...)
+}
+
+func TestSniffPingDelayDisconnect(t *testing.T) {
+	var (
+		pdd  = message(7, pingDelayDisconnect(9, 75))
+		ping = message(3, constructor(mtproto.CRC32_ping, 8))
+		both = message(11, container(ping, pdd))
+	)
+
+	cases := []struct {
+		name       string
+		b          []byte
+		wantOk     bool
+		wantMsgId  int64
+		wantPingId int64
+		wantDelay  int32
+	}{
+		{"alone", pdd, true, 7, 9, 75},
+		{"in a container", both, true, 7, 9, 75},
+		{"ping only", ping, false, 0, 0, 0},
+		{"empty", nil, false, 0, 0, 0},
+		{"shorter than a message", pdd[:19], false, 0, 0, 0},
+		{"truncated body", pdd[:len(pdd)-1], false, 0, 0, 0},
+		{"truncated container", both[:len(both)-1], false, 0, 0, 0},
+		{"container header only", both[:20], false, 0, 0, 0},
+		{"container of more messages than it holds", message(11, withCount(container(ping), 1000)), false, 0, 0, 0},
+		{"oversized message in a container", withLen(both, 24, 0xffffffff), false, 0, 0, 0},
+		{"oversized ping_delay_disconnect in a container", withLen(both, 24+len(ping), 1<<20), false, 0, 0, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgId, pingId, delay, ok := sniffPingDelayDisconnect(tc.b)
+			if ok != tc.wantOk || msgId != tc.wantMsgId || pingId != tc.wantPingId || delay != tc.wantDelay {
+				t.Fatalf("sniffPingDelayDisconnect() = %d, %d, %d, %v, want %d, %d, %d, %v",
+					msgId, pingId, delay, ok, tc.wantMsgId, tc.wantPingId, tc.wantDelay, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestSniffClientMessage(t *testing.T) {
+	var (
+		pdd    = message(7, pingDelayDisconnect(9, 75))
+		ping   = message(3, constructor(mtproto.CRC32_ping, 8))
+		ack    = message(5, constructor(mtproto.CRC32_msgs_ack, 8))
+		rpc    = message(13, constructor(mtproto.CRC32_help_getConfig, 0))
+		keep   = message(11, container(ping, pdd))
+		rpcAck = message(15, container(ack, rpc))
+	)
+
+	cases := []struct {
+		name          string
+		b             []byte
+		wantKeepAlive bool
+		wantRpc       bool
+	}{
+		{"ping", ping, false, false},
+		{"ping_delay_disconnect", pdd, true, false},
+		{"rpc", rpc, false, true},
+		{"container of service messages", keep, true, false},
+		{"container with an rpc", rpcAck, false, true},
+		{"empty", nil, false, false},
+		{"shorter than a message", rpc[:19], false, false},
+		{"container header only", keep[:20], false, false},
+		{"truncated container", keep[:len(keep)-len(pdd)+19], false, false},
+		{"container of more messages than it holds", message(11, withCount(container(ping), 1000)), false, false},
+		// the first message is sniffed, its len goes past the others
+		{"oversized message in a container", withLen(rpcAck, 24, 0xffffffff), false, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			keepAlive, rpc := sniffClientMessage(tc.b)
+			if keepAlive != tc.wantKeepAlive || rpc != tc.wantRpc {
+				t.Fatalf("sniffClientMessage() = %v, %v, want %v, %v", keepAlive, rpc, tc.wantKeepAlive, tc.wantRpc)
+			}
+		})
+	}
+}
+
+func TestDroppableServerMessage(t *testing.T) {
+	var (
+		updates     = message(1, constructor(mtproto.CRC32_updatesTooLong, 0))
+		updateShort = message(5, constructor(mtproto.CRC32_updateShort, 12))
+		pong        = message(9, constructor(mtproto.CRC32_pong, 16))
+		onlyUpdates = serverMessage(container(updates, updateShort))
+	)
+
+	cases := []struct {
+		name string
+		b    []byte
+		want bool
+	}{
+		{"updates", serverMessage(constructor(mtproto.CRC32_updatesTooLong, 0)), true},
+		{"rpc_result", serverMessage(constructor(mtproto.CRC32_rpc_result, 8)), false},
+		{"container of updates", onlyUpdates, true},
+		{"container with a pong", serverMessage(container(updates, pong)), false},
+		{"empty container", serverMessage(container()), false},
+		{"empty", nil, false},
+		{"shorter than a message", onlyUpdates[:35], false},
+		{"container header only", onlyUpdates[:36], false},
+		{"truncated container", onlyUpdates[:len(onlyUpdates)-len(updateShort)+19], false},
+		{"container of more messages than it holds", serverMessage(withCount(container(updates), 1000)), false},
+		{"oversized message in a container", withLen(onlyUpdates, 40, 0xffffffff), false},
+		{"oversized last message in a container", withLen(onlyUpdates, 40+len(updates), 1<<20), false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := droppableServerMessage(tc.b); got != tc.want {
+				t.Fatalf("droppableServerMessage() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContentRelated(t *testing.T) {
+	cases := []struct {
+		name string
+		body []byte
+		want bool
+	}{
+		{"msgs_ack", constructor(mtproto.CRC32_msgs_ack, 8), false},
+		{"pong", constructor(mtproto.CRC32_pong, 16), false},
+		{"msgs_state_info", constructor(mtproto.CRC32_msgs_state_info, 12), false},
+		{"msg_new_detailed_info", constructor(mtproto.CRC32_msg_new_detailed_info, 16), false},
+		{"updates", constructor(mtproto.CRC32_updatesTooLong, 0), true},
+		{"rpc_result", constructor(mtproto.CRC32_rpc_result, 8), true},
+		{"container", container(message(5, constructor(mtproto.CRC32_msgs_ack, 8))), true},
+		{"empty", nil, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := contentRelated(tc.body); got != tc.want {
+				t.Fatalf("contentRelated() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
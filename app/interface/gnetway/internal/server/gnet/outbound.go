@@ -0,0 +1,233 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+
+	"github.com/gobwas/ws"
+	"github.com/panjf2000/gnet/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// outboundRetryDelay is how long a flush waits for the socket of a connection to drain.
+const outboundRetryDelay = 20 * time.Millisecond
+
+var errSlowConsumer = errors.New("slow consumer")
+
+type outboundMsg struct {
+	data      []byte
+	droppable bool
+}
+
+// outboundQueue is what waits to be written to a connection, it is only used on the
+// event loop of the connection. The messages are encoded by the codec of the connection
+// when they are flushed, in the order they are written to the socket.
+type outboundQueue struct {
+	msgs      []outboundMsg
+	queued    int // bytes in msgs
+	inflight  int // bytes handed to AsyncWritev and not written yet
+	scheduled bool
+	retrying  bool
+}
+
+func (q *outboundQueue) push(data []byte, droppable bool) {
+	q.msgs = append(q.msgs, outboundMsg{data: data, droppable: droppable})
+	q.queued += len(data)
+}
+
+// dropOldest drops the oldest droppable messages until n bytes are freed or none is left,
+// it returns the bytes and the messages dropped.
+func (q *outboundQueue) dropOldest(n int) (freed, dropped int) {
+	msgs := q.msgs[:0]
+	for _, m := range q.msgs {
+		if freed < n && m.droppable {
+			freed += len(m.data)
+			dropped++
+			continue
+		}
+		msgs = append(msgs, m)
+	}
+	for i := len(msgs); i < len(q.msgs); i++ {
+		q.msgs[i] = outboundMsg{}
+	}
+	q.msgs = msgs
+	q.queued -= freed
+
+	return
+}
+
+// pending is what c has to write yet, ours and what gnet buffers.
+func (q *outboundQueue) pending(c gnet.Conn) int {
+	return q.queued + q.inflight + c.OutboundBuffered()
+}
+
+// queueWrite queues msg for c and triggers a flush behind what is already on the event
+// loop, the writes of a burst go out in one writev.
+func (s *Server) queueWrite(c gnet.Conn, ctx *connContext, msg []byte, droppable bool) error {
+	if err := s.queue(c, ctx, msg, droppable); err != nil {
+		return err
+	}
+
+	q := &ctx.outbound
+	if !q.scheduled {
+		q.scheduled = true
+		s.eng.Trigger(c.ConnId(), s.flushOutbound)
+	}
+
+	return nil
+}
+
+// queue queues msg for c, it applies the slow consumer policy when the connection has
+// more than MaxPendingBytes to write.
+func (s *Server) queue(c gnet.Conn, ctx *connContext, msg []byte, droppable bool) error {
+	q := &ctx.outbound
+	if pending := q.pending(c); pending+len(msg) > s.c.Gnetway.Outbound.MaxPendingBytes {
+		if !s.makeRoom(c, q, pending, len(msg), droppable) {
+			return errSlowConsumer
+		}
+	}
+
+	q.push(msg, droppable)
+	return nil
+}
+
+// makeRoom makes room for n more bytes on a connection with pending bytes to write
+// and reports whether they may be queued. Dropping nothing else, a droppable message
+// is dropped itself, any other closes the connection.
+func (s *Server) makeRoom(c gnet.Conn, q *outboundQueue, pending, n int, droppable bool) bool {
+	maxPending := s.c.Gnetway.Outbound.MaxPendingBytes
+
+	if s.c.Gnetway.Outbound.SlowConsumer == config.SlowConsumerDrop {
+		freed, dropped := q.dropOldest(pending + n - maxPending)
+		pending -= freed
+		if pending+n <= maxPending {
+			logx.Infof("conn(%s) slow consumer, dropped %d updates, pending: %d", c, dropped, pending)
+			return true
+		}
+		if droppable {
+			logx.Infof("conn(%s) slow consumer, dropped %d updates, pending: %d", c, dropped+1, pending)
+			return false
+		}
+	}
+
+	logx.Errorf("conn(%s) slow consumer, pending: %d, close it", c, pending)
+	_ = c.Close()
+	return false
+}
+
+// flushOutbound writes what is queued for c. It holds it back while gnet still buffers
+// what we wrote before, where the slow consumer policy can't reach.
+func (s *Server) flushOutbound(c gnet.Conn) {
+	ctx, _ := c.Context().(*connContext)
+	if ctx == nil {
+		return
+	}
+
+	ctx.outbound.scheduled = false
+	if err := s.flush(c, ctx); err != nil {
+		logx.Errorf("conn(%s) flush error: %v", c, err)
+	}
+}
+
+// flush writes what is queued for c unless gnet still buffers, then it retries later.
+func (s *Server) flush(c gnet.Conn, ctx *connContext) error {
+	q := &ctx.outbound
+	if len(q.msgs) == 0 {
+		return nil
+	}
+
+	if c.OutboundBuffered() > 0 {
+		if !q.retrying {
+			q.retrying = true
+			connId := c.ConnId()
+			time.AfterFunc(outboundRetryDelay, func() {
+				s.eng.Trigger(connId, func(c gnet.Conn) {
+					if ctx, _ := c.Context().(*connContext); ctx != nil {
+						ctx.outbound.retrying = false
+						s.flushOutbound(c)
+					}
+				})
+			})
+		}
+		return nil
+	}
+
+	return writeOutbound(c, ctx)
+}
+
+// writeOutbound encodes everything queued for c and hands it to AsyncWritev. It closes
+// c if a message doesn't encode, the codec advanced its cipher over the ones before,
+// which never reach the client: nothing written after would decrypt.
+func writeOutbound(c gnet.Conn, ctx *connContext) error {
+	q := &ctx.outbound
+	if len(q.msgs) == 0 {
+		return nil
+	}
+
+	bufs := make([][]byte, 0, 2*len(q.msgs))
+	for _, m := range q.msgs {
+		data, err := ctx.codec.Encode(c, m.data)
+		if err != nil {
+			for i := range q.msgs {
+				q.msgs[i] = outboundMsg{}
+			}
+			q.msgs, q.queued = nil, 0
+			_ = c.Close()
+			return err
+		}
+		if ctx.websocket {
+			bufs = append(bufs, wsBinaryHeader(len(data)))
+		}
+		bufs = append(bufs, data)
+	}
+
+	n := q.queued
+	q.msgs, q.queued = nil, 0
+	q.inflight += n
+
+	err := c.AsyncWritev(bufs, func(c gnet.Conn, err error) error {
+		q.inflight -= n
+		if err != nil {
+			logx.Errorf("conn(%s) write error: %v", c, err)
+		}
+		return nil
+	})
+	if err != nil {
+		q.inflight -= n
+	}
+
+	return err
+}
+
+// wsBinaryHeader is the header of an unmasked binary frame of n bytes, as a server sends it.
+func wsBinaryHeader(n int) []byte {
+	var (
+		h = ws.Header{
+			Fin:    true,
+			OpCode: ws.OpBinary,
+			Length: int64(n),
+		}
+		b = bytes.NewBuffer(make([]byte, 0, ws.MaxHeaderSize))
+	)
+
+	_ = ws.WriteHeader(b, h)
+	return b.Bytes()
+}
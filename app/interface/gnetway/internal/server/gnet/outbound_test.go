@@ -0,0 +1,133 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/gnet/codec"
+
+	"github.com/panjf2000/gnet/v2"
+)
+
+var errEncode = errors.New("encode error")
+
+// cipherCodec stands for a stateful codec, every message it encodes advances it.
+type cipherCodec struct {
+	codec.Codec
+	encoded int
+	fail    []byte
+}
+
+func (c *cipherCodec) Encode(conn codec.CodecWriter, msg []byte) ([]byte, error) {
+	c.encoded++
+	if bytes.Equal(msg, c.fail) {
+		return nil, errEncode
+	}
+	return append([]byte{byte(c.encoded)}, msg...), nil
+}
+
+// outboundConn is a gnet.Conn keeping what is written to it.
+type outboundConn struct {
+	gnet.Conn
+	ctx      *connContext
+	buffered int
+	written  [][]byte
+	closed   bool
+}
+
+func (c *outboundConn) ConnId() int64        { return 1 }
+func (c *outboundConn) Context() interface{} { return c.ctx }
+func (c *outboundConn) Close() error         { c.closed = true; return nil }
+func (c *outboundConn) String() string       { return "test" }
+func (c *outboundConn) OutboundBuffered() int {
+	return c.buffered
+}
+
+func (c *outboundConn) AsyncWritev(bufs [][]byte, callback gnet.AsyncCallback) error {
+	c.written = append(c.written, bufs...)
+	return callback(c, nil)
+}
+
+func newOutboundServer(maxPending int, slowConsumer string) *Server {
+	return &Server{c: &config.Config{Gnetway: &config.GnetwayConfig{
+		Outbound: config.OutboundConfig{MaxPendingBytes: maxPending, SlowConsumer: slowConsumer},
+	}}}
+}
+
+func TestUnThreadSafeWrite(t *testing.T) {
+	cases := []struct {
+		name        string
+		buffered    int
+		queued      [][]byte
+		slow        string
+		wantWritten int
+		wantQueued  int
+		wantClosed  bool
+		wantErr     error
+	}{
+		{"right away", 0, nil, config.SlowConsumerDrop, 1, 0, false, nil},
+		{"behind what is queued", 0, [][]byte{[]byte("queued")}, config.SlowConsumerDrop, 2, 0, false, nil},
+		{"held back while gnet buffers", 10, nil, config.SlowConsumerDrop, 0, 1, false, nil},
+		{"slow consumer", 60, nil, config.SlowConsumerClose, 0, 0, true, errSlowConsumer},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newOutboundServer(64, tc.slow)
+			ctx := &connContext{codec: &cipherCodec{}}
+			for _, m := range tc.queued {
+				ctx.outbound.push(m, false)
+			}
+			c := &outboundConn{ctx: ctx, buffered: tc.buffered}
+
+			if err := s.UnThreadSafeWrite(c, []byte("handshake")); err != tc.wantErr {
+				t.Fatalf("UnThreadSafeWrite() error = %v, want %v", err, tc.wantErr)
+			}
+			if len(c.written) != tc.wantWritten || len(ctx.outbound.msgs) != tc.wantQueued || c.closed != tc.wantClosed {
+				t.Fatalf("written %d, queued %d, closed %v, want %d, %d, %v",
+					len(c.written), len(ctx.outbound.msgs), c.closed, tc.wantWritten, tc.wantQueued, tc.wantClosed)
+			}
+			if tc.wantWritten > 0 && !bytes.HasSuffix(c.written[len(c.written)-1], []byte("handshake")) {
+				t.Errorf("the last write is %q", c.written[len(c.written)-1])
+			}
+		})
+	}
+}
+
+// TestWriteOutboundEncodeError checks a connection whose codec advanced over messages
+// that are never written is closed, nothing is written to it anymore.
+func TestWriteOutboundEncodeError(t *testing.T) {
+	cc := &cipherCodec{fail: []byte("bad")}
+	ctx := &connContext{codec: cc}
+	c := &outboundConn{ctx: ctx}
+	for _, m := range []string{"first", "bad", "last"} {
+		ctx.outbound.push([]byte(m), false)
+	}
+
+	if err := writeOutbound(c, ctx); err != errEncode {
+		t.Fatalf("writeOutbound() error = %v, want %v", err, errEncode)
+	}
+	if !c.closed || len(c.written) != 0 {
+		t.Fatalf("closed %v, written %d, want closed with nothing written", c.closed, len(c.written))
+	}
+	if q := &ctx.outbound; len(q.msgs) != 0 || q.queued != 0 {
+		t.Fatalf("%d messages of %d bytes left to encode again", len(q.msgs), q.queued)
+	}
+}
@@ -17,10 +17,11 @@ package gnet
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
-	"github.com/teamgram/marmota/pkg/cache"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/dao"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/svc"
 
 	"github.com/panjf2000/gnet/v2"
@@ -38,10 +39,13 @@ type Server struct {
 	gnet.BuiltinEventEngine
 	eng            gnet.Engine
 	pool           *goroutine.Pool
-	cache          *cache.LRUCache
+	authKeyCache   *authKeyCache
 	c              *config.Config
 	handshake      *handshake
 	authSessionMgr *authSessionManager
+	idleWheel      *timingWheel
+	draining       atomic.Bool
+	drain          drainState
 	svcCtx         *svc.ServiceContext
 	tickNumber     int64
 }
@@ -52,14 +56,18 @@ func New(svcCtx *svc.ServiceContext, c config.Config) *Server {
 	)
 
 	s.authSessionMgr = NewAuthSessionManager()
+	s.idleWheel = newTimingWheel(time.Now().Unix())
+	s.drain.done = make(chan struct{})
 
 	s.handshake = mustNewHandshake(c.RSAKey)
 
-	s.cache = cache.NewLRUCache(10 * 1024 * 1024) // cache capacity: 10MB
+	s.authKeyCache = newAuthKeyCache(c.Gnetway.AuthKeyCache)
 	s.pool = goroutine.Default()
 
 	s.c = &c
 	s.svcCtx = svcCtx
+	s.svcCtx.SetStreamHandler(s.onSessionStreamItem)
+	s.svcCtx.SetMovedKeysHook(s.authSessionMgr.ShardKeys, s.onSessionKeysMoved)
 
 	go func() {
 		s.Serve()
@@ -67,7 +75,10 @@ func New(svcCtx *svc.ServiceContext, c config.Config) *Server {
 	return s
 }
 
+// Close drains the gnetway in Gnetway.Drain.Window and stops it.
 func (s *Server) Close() {
+	s.Drain(0)
+
 	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 	defer cancel()
 	logx.Debugf("stop engine... error: %v", s.eng.Stop(ctx))
@@ -93,3 +104,12 @@ func (s *Server) Serve() {
 		panic(err)
 	}
 }
+
+// onSessionKeysMoved is called when the session nodes changed and some of our auth keys
+// are owned by another node now.
+func (s *Server) onSessionKeysMoved(moved []dao.MovedKey) {
+	logx.Infof("session nodes changed, %d auth keys moved", len(moved))
+	for _, m := range moved {
+		logx.Debugf("session key(%s) moved: %s -> %s", m.Key, m.From, m.To)
+	}
+}
@@ -21,8 +21,6 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"math/rand"
-	"strconv"
 	"strings"
 	"time"
 
@@ -32,7 +30,6 @@ import (
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
 
-	"github.com/gobwas/ws/wsutil"
 	"github.com/panjf2000/gnet/v2"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/protobuf/proto"
@@ -63,6 +60,21 @@ func (s *Server) asyncRun2(
 	})
 }
 
+// dispatchRun2 is asyncRun2 behind the frames the connection has pending, on its dispatcher.
+func (s *Server) dispatchRun2(
+	d *connDispatcher,
+	connId int64,
+	mmsg []byte,
+	execb func(mmsg []byte) (interface{}, error),
+	retcb func(c gnet.Conn, mmsg []byte, in interface{}, err error)) {
+	d.Dispatch(func() {
+		r, err := execb(mmsg)
+		s.eng.Trigger(connId, func(c gnet.Conn) {
+			retcb(c, mmsg, r, err)
+		})
+	})
+}
+
 // OnBoot fires when the engine is ready for accepting connections.
 // The parameter engine has information and various utilities.
 func (s *Server) OnBoot(eng gnet.Engine) (action gnet.Action) {
@@ -82,6 +94,11 @@ func (s *Server) OnShutdown(eng gnet.Engine) {
 // The parameter out is the return value which is going to be sent back to the peer.
 func (s *Server) OnOpen(c gnet.Conn) (out []byte, action gnet.Action) {
 	logx.Debugf("onNewConn - conn(%s)", c)
+	if s.Draining() {
+		logx.Debugf("conn(%s) refused, draining", c)
+		action = gnet.Close
+		return
+	}
 
 	ctx := newConnContext()
 	ctx.setClientIp(strings.Split(c.RemoteAddr().String(), ":")[0])
@@ -90,8 +107,15 @@ func (s *Server) OnOpen(c gnet.Conn) (out []byte, action gnet.Action) {
 	if ctx.websocket {
 		ctx.wsCodec = new(ws.WsCodec)
 	}
-	ctx.closeDate = time.Now().Unix() + 30
+	connId := c.ConnId()
+	ctx.dispatcher = newConnDispatcher(
+		s.c.Gnetway.Dispatch.MaxPending,
+		s.pool.Submit,
+		func() {
+			s.resumeTraffic(connId)
+		})
 	c.SetContext(ctx)
+	s.touchIdle(c, ctx)
 
 	return
 }
@@ -100,6 +124,7 @@ func (s *Server) OnOpen(c gnet.Conn) (out []byte, action gnet.Action) {
 // The parameter err is the last known connection error.
 func (s *Server) OnClose(c gnet.Conn, err error) (action gnet.Action) {
 	logx.Debugf("onConnClosed - conn(%s), err: %v", c, err)
+	s.idleWheel.Remove(c.ConnId())
 
 	ctx, _ := c.Context().(*connContext)
 	if ctx == nil {
@@ -124,9 +149,11 @@ func (s *Server) OnClose(c gnet.Conn, err error) (action gnet.Action) {
 		return
 	}
 
-	_ = s.pool.Submit(func() {
-		_ = s.svcCtx.ShardingSessionClient.InvokeByKey(
-			strconv.FormatInt(ctx.authKey.PermAuthKeyId(), 10),
+	// after the pending frames of this connection
+	ctx.dispatcher.Dispatch(func() {
+		_ = s.svcCtx.ShardingSessionClient.InvokeByAuthKey(
+			ctx.authKey.AuthKeyId(),
+			ctx.authKey.PermAuthKeyId(),
 			func(client sessionclient.SessionClient) (err error) {
 				_, err = client.SessionCloseSession(context.Background(), &session.TLSessionCloseSession{
 					Client: session.MakeTLSessionClientEvent(&session.SessionClientEvent{
@@ -148,12 +175,50 @@ func (s *Server) OnClose(c gnet.Conn, err error) (action gnet.Action) {
 // OnTraffic fires when a local socket receives data from the peer.
 func (s *Server) OnTraffic(c gnet.Conn) (action gnet.Action) {
 	ctx := c.Context().(*connContext)
-	ctx.closeDate = time.Now().Unix() + 300 + rand.Int63()%10
 	if ctx.websocket {
-		return s.onWebsocketData(ctx, c)
+		action = s.onWebsocketData(ctx, c)
 	} else {
-		return s.onTcpData(ctx, c)
+		action = s.onTcpData(ctx, c)
+	}
+	// after the data, it may have brought the auth key
+	s.touchIdle(c, ctx)
+
+	return
+}
+
+// resumeTraffic decodes the frames left in the inbound buffer while the session was behind.
+func (s *Server) resumeTraffic(connId int64) {
+	s.eng.Trigger(connId, func(c gnet.Conn) {
+		if ctx, _ := c.Context().(*connContext); ctx == nil {
+			return
+		}
+		if s.OnTraffic(c) == gnet.Close {
+			_ = c.Close()
+		}
+	})
+}
+
+// paused reports whether the connection has to leave the data in its inbound buffer
+// until the session catches up. It closes the connection if too much data piles up.
+//
+// This is no backpressure on the socket, gnet keeps reading it into the inbound buffer,
+// only the decoding stops. MaxInboundBytes is what bounds the memory of a connection, a
+// websocket one counts what its codec holds too.
+func (s *Server) paused(ctx *connContext, c gnet.Conn) (bool, gnet.Action) {
+	if !ctx.dispatcher.Overloaded() {
+		return false, gnet.None
+	}
+
+	inbound := c.InboundBuffered()
+	if ctx.wsCodec != nil {
+		inbound += ctx.wsCodec.Buf.Len() + ctx.wsCodec.Conn.InboundBuffered()
 	}
+	if inbound > s.c.Gnetway.Dispatch.MaxInboundBytes {
+		logx.Errorf("conn(%s) session is too slow, pending: %d, inbound: %d", c, ctx.dispatcher.Pending(), inbound)
+		return true, gnet.Close
+	}
+
+	return true, gnet.None
 }
 
 // OnTick fires immediately after the engine starts and will fire again
@@ -164,21 +229,55 @@ func (s *Server) OnTick() (delay time.Duration, action gnet.Action) {
 		logx.Statf("conn count: %d", s.eng.CountConnections())
 	}
 	delay = time.Second * 1
-	now := time.Now().Unix()
 
-	s.eng.Iterate(func(c gnet.Conn) {
-		ctx, _ := c.Context().(*connContext)
-		if ctx == nil {
-			return
-		}
-		if now >= ctx.closeDate {
-			logx.Debugf("close conn(%s) by timeout", c)
-			_ = c.Close()
-		}
-	})
+	if s.tickNumber%60 == 0 {
+		_ = s.pool.Submit(s.logAuthKeyCacheStat)
+	}
+
+	s.closeIdleConns(time.Now().Unix())
 	return
 }
 
+// sendTransportError writes a transport error code (e.g. -404) to the client
+func (s *Server) sendTransportError(c gnet.Conn, code int32) {
+	out := make([]byte, 4)
+	binary.LittleEndian.PutUint32(out, uint32(code))
+	_ = s.UnThreadSafeWrite(c, out)
+}
+
+// writePong answers the ping pingId of the message msgId.
+func (s *Server) writePong(c gnet.Conn, authKey *authKeyUtil, salt, sessionId, msgId, pingId int64) {
+	payload := serializeToBuffer2(salt, sessionId, &mtproto.TLMessage2{
+		MsgId: nextMessageId(false),
+		Seqno: 0,
+		Bytes: 0,
+		Object: mtproto.MakeTLPong(&mtproto.Pong{
+			MsgId:  msgId,
+			PingId: pingId,
+		}).To_Pong(),
+	})
+
+	_ = s.UnThreadSafeWrite(c, encryptToClient(authKey, payload))
+}
+
+// onPingDelayDisconnect answers a ping_delay_disconnect and has c closed delay seconds
+// later, unless another one comes first, whatever else the client sends. This is how
+// Android tells how long to keep its push connection open, 0 goes back to the idle timeout.
+func (s *Server) onPingDelayDisconnect(c gnet.Conn, ctx *connContext, authKey *authKeyUtil, salt, sessionId, msgId, pingId int64, delay int32) {
+	s.writePong(c, authKey, salt, sessionId, msgId, pingId)
+
+	if delay <= 0 {
+		ctx.disconnectAt = 0
+		return
+	}
+	if delay > maxDisconnectDelay {
+		delay = maxDisconnectDelay
+	}
+	ctx.disconnectAt = time.Now().Unix() + int64(delay)
+	ctx.closeDate = ctx.disconnectAt
+	s.scheduleIdle(c.ConnId(), ctx)
+}
+
 func (s *Server) onEncryptedMessage(c gnet.Conn, ctx *connContext, authKey *authKeyUtil, needAck bool, mmsg []byte) error {
 	mtpRwaData, err := authKey.AesIgeDecrypt(mmsg[8:8+16], mmsg[24:])
 	if err != nil {
@@ -192,6 +291,13 @@ func (s *Server) onEncryptedMessage(c gnet.Conn, ctx *connContext, authKey *auth
 		sessionId     = int64(binary.LittleEndian.Uint64(mtpRwaData[8:]))
 	)
 
+	// answered here only, the session skips it in a container and doesn't get it alone
+	var answered bool
+	if msgId, pingId, delay, ok := sniffPingDelayDisconnect(mtpRwaData[16:]); ok {
+		s.onPingDelayDisconnect(c, ctx, authKey, salt, sessionId, msgId, pingId, delay)
+		answered = mtproto.TLConstructor(binary.LittleEndian.Uint32(mtpRwaData[32:])) != mtproto.CRC32_msg_container
+	}
+
 	if permAuthKeyId == 0 {
 		// hack
 		for _, unknown := range tryGetUnknownTLObject(mtpRwaData[16:]) {
@@ -202,24 +308,9 @@ func (s *Server) onEncryptedMessage(c gnet.Conn, ctx *connContext, authKey *auth
 				clone := proto.Clone(authKey.keyData).(*mtproto.AuthKeyInfo)
 				clone.PermAuthKeyId = permAuthKeyId
 				authKey.keyData = clone
-				s.PutAuthKey(clone)
+				s.PutAuthKey(clone, authKey.ExpiresAt())
 			case *mtproto.TLPing:
-				payload := serializeToBuffer2(salt, sessionId, &mtproto.TLMessage2{
-					MsgId: nextMessageId(false),
-					Seqno: 0,
-					Bytes: 0,
-					Object: mtproto.MakeTLPong(&mtproto.Pong{
-						MsgId:  int64(binary.LittleEndian.Uint64(mtpRwaData[16:])),
-						PingId: unknownMsg.PingId,
-					}).To_Pong(),
-				})
-
-				msgKey, mtpRawData, _ := authKey.AesIgeEncrypt(payload)
-				x2 := mtproto.NewEncodeBuf(8 + len(msgKey) + len(mtpRawData))
-				x2.Long(authKey.AuthKeyId())
-				x2.Bytes(msgKey)
-				x2.Bytes(mtpRawData)
-				_ = UnThreadSafeWrite(c, x2.GetBuf())
+				s.writePong(c, authKey, salt, sessionId, int64(binary.LittleEndian.Uint64(mtpRwaData[16:])), unknownMsg.PingId)
 
 				return nil
 			default:
@@ -234,22 +325,29 @@ func (s *Server) onEncryptedMessage(c gnet.Conn, ctx *connContext, authKey *auth
 	}
 
 	var (
-		isNew    = ctx.sessionId != sessionId
-		clientIp = ctx.clientIp
-		connId   = c.ConnId()
+		isNew          = ctx.sessionId != sessionId
+		clientIp       = ctx.clientIp
+		connId         = c.ConnId()
+		transport      = connTransportTcp
+		keepAlive, rpc = sniffClientMessage(mtpRwaData[16:])
 	)
+	if ctx.websocket {
+		transport = connTransportWebsocket
+	}
 	if isNew {
 		ctx.sessionId = sessionId
 	} else {
 		// check sessionId??
 	}
 
-	_ = s.pool.Submit(func() {
-		_ = s.svcCtx.Dao.ShardingSessionClient.InvokeByKey(
-			strconv.FormatInt(permAuthKeyId, 10),
+	// createSession and the frames of a connection must reach the session in order
+	ctx.dispatcher.Dispatch(func() {
+		_ = s.svcCtx.Dao.ShardingSessionClient.InvokeByAuthKey(
+			authKey.AuthKeyId(),
+			permAuthKeyId,
 			func(client sessionclient.SessionClient) (err error) {
 				if isNew {
-					if s.authSessionMgr.AddNewSession(authKey, sessionId, connId) {
+					if s.authSessionMgr.AddNewSession(authKey, sessionId, connId, transport) {
 						_, err = client.SessionCreateSession(context.Background(), &session.TLSessionCreateSession{
 							Client: session.MakeTLSessionClientEvent(&session.SessionClientEvent{
 								ServerId:      s.svcCtx.GatewayId,
@@ -262,6 +360,10 @@ func (s *Server) onEncryptedMessage(c gnet.Conn, ctx *connContext, authKey *auth
 						})
 					}
 				}
+				s.authSessionMgr.TouchConn(authKey.AuthKeyId(), sessionId, connId, salt, keepAlive, rpc)
+				if answered {
+					return
+				}
 
 				_, err = client.SessionSendDataToSession(context.Background(), &session.TLSessionSendDataToSession{
 					Data: &session.SessionClientData{
@@ -332,14 +434,21 @@ func (s *Server) onMTPRawMessage(ctx *connContext, c gnet.Conn, authKeyId int64,
 		if err != nil {
 			action = gnet.Close
 		} else if out != nil {
-			_ = UnThreadSafeWrite(c, out)
+			_ = s.UnThreadSafeWrite(c, out)
 		}
 	} else {
 		authKey := ctx.getAuthKey()
 		if authKey == nil {
-			key := s.GetAuthKey(authKeyId)
-			if key != nil {
-				authKey = newAuthKeyUtil(key)
+			if v, ok := s.GetAuthKey(authKeyId); !ok {
+				// query it from session
+			} else if v.V == nil {
+				logx.Infof("conn(%s) auth_key(%d) unregistered", c, authKeyId)
+				s.sendTransportError(c, -404)
+				action = gnet.Close
+				return
+			} else {
+				authKey = newAuthKeyUtil(v.V)
+				authKey.expiresAt = v.ExpiresAt
 				ctx.putAuthKey(authKey)
 			}
 		} else if authKey.AuthKeyId() != authKeyId {
@@ -348,7 +457,15 @@ func (s *Server) onMTPRawMessage(ctx *connContext, c gnet.Conn, authKeyId int64,
 			return
 		}
 
-		if authKey != nil {
+		if authKey != nil && authKey.Expired(time.Now().Unix()) {
+			// the temp key expired, the client has to create a new one
+			logx.Infof("conn(%s) auth_key(%d) expired", c, authKeyId)
+			s.sendTransportError(c, -404)
+			action = gnet.Close
+			return
+		}
+
+		if authKey != nil && ctx.resolving == 0 {
 			err := s.onEncryptedMessage(c, ctx, authKey, needAck, msg2)
 			if err != nil {
 				action = gnet.Close
@@ -362,46 +479,71 @@ func (s *Server) onMTPRawMessage(ctx *connContext, c gnet.Conn, authKeyId int64,
 		msg2Clone := make([]byte, len(msg2))
 		copy(msg2Clone, msg2)
 
-		s.asyncRun2(
+		// the frames before it wait for the key, take the same way not to overtake them
+		ctx.resolving++
+		s.dispatchRun2(
+			ctx.dispatcher,
 			c.ConnId(),
 			msg2Clone,
 			func(mmsg []byte) (interface{}, error) {
 				var (
-					key3 *mtproto.AuthKeyInfo
+					key3 *session.SessionAuthKeyInfo
 				)
 
-				err2 := s.svcCtx.Dao.ShardingSessionClient.InvokeByKey(
-					strconv.FormatInt(authKeyId, 10),
+				// known by now, or found for a frame before
+				if authKey != nil {
+					return authKey, nil
+				}
+				if v, ok := s.GetAuthKey(authKeyId); ok {
+					if v.V == nil {
+						return nil, mtproto.ErrAuthKeyUnregistered
+					}
+					authKey2 := newAuthKeyUtil(v.V)
+					authKey2.expiresAt = v.ExpiresAt
+					return authKey2, nil
+				}
+
+				// we don't know the perm key, the node of the temp key resolves the binding
+				err2 := s.svcCtx.Dao.ShardingSessionClient.InvokeByAuthKey(
+					authKeyId,
+					0,
 					func(client sessionclient.SessionClient) (err error) {
-						key3, err = client.SessionQueryAuthKey(context.Background(), &session.TLSessionQueryAuthKey{
+						key3, err = client.SessionQueryAuthKeyInfo(context.Background(), &session.TLSessionQueryAuthKeyInfo{
 							AuthKeyId: authKeyId,
 						})
 						return
 					})
 				if err2 != nil {
-					logx.Errorf("conn(%s) sessionQueryAuthKey error: %v", c, err2)
+					logx.Errorf("conn(%s) sessionQueryAuthKeyInfo error: %v", c, err2)
 					return nil, err2
+				} else if key3.GetAuthKey() == nil {
+					logx.Errorf("conn(%s) sessionQueryAuthKeyInfo - auth_key(%d) without key", c, authKeyId)
+					return nil, mtproto.ErrAuthKeyUnregistered
 				} else {
-					s.PutAuthKey(key3)
+					// a temp key expires on the gnetway when it expires on the session
+					s.PutAuthKey(key3.GetAuthKey(), key3.GetExpiresAt())
 				}
 
-				return newAuthKeyUtil(key3), nil
+				authKey2 := newAuthKeyUtil(key3.GetAuthKey())
+				authKey2.expiresAt = key3.GetExpiresAt()
+
+				return authKey2, nil
 			},
 			func(c2 gnet.Conn, mmsg []byte, in interface{}, err error) {
+				if ctx2, _ := c2.Context().(*connContext); ctx2 != nil {
+					ctx2.resolving--
+				}
 				if err != nil {
 					if errors.Is(err, mtproto.ErrAuthKeyUnregistered) {
-						out2 := make([]byte, 4)
-						var (
-							code = int32(-404)
-						)
-						binary.LittleEndian.PutUint32(out2, uint32(code))
-						_ = UnThreadSafeWrite(c2, out2)
+						s.PutUnknownAuthKey(authKeyId)
+						s.sendTransportError(c2, -404)
 					}
 					_ = c2.Close()
 				} else {
 					authKey2 := in.(*authKeyUtil)
 					ctx2 := c2.Context().(*connContext)
 					ctx2.putAuthKey(authKey2)
+					s.touchIdle(c2, ctx2)
 					err = s.onEncryptedMessage(c2, ctx2, authKey2, needAck, mmsg)
 					if err != nil {
 						_ = c2.Close()
@@ -413,7 +555,10 @@ func (s *Server) onMTPRawMessage(ctx *connContext, c gnet.Conn, authKeyId int64,
 	return gnet.None
 }
 
-func UnThreadSafeWrite(c gnet.Conn, msg []byte) error {
+// UnThreadSafeWrite writes msg to c right away, on the event loop of c. It goes behind
+// what is queued for c, the codec must encode in the order of the socket, and is held
+// back with it while gnet still buffers, under the slow consumer policy.
+func (s *Server) UnThreadSafeWrite(c gnet.Conn, msg []byte) error {
 	ctx := c.Context().(*connContext)
 
 	if ctx.codec == nil {
@@ -424,21 +569,9 @@ func UnThreadSafeWrite(c gnet.Conn, msg []byte) error {
 		return nil
 	}
 
-	data, err := ctx.codec.Encode(c, msg)
-	if err != nil {
+	if err := s.queue(c, ctx, msg, false); err != nil {
 		return err
 	}
 
-	if ctx.websocket {
-		// This is the echo server
-		err = wsutil.WriteServerBinary(c, data)
-		if err != nil {
-			logx.Infof("conn[%v] [err=%v]", c.RemoteAddr().String(), err.Error())
-			return err
-		}
-	} else {
-		_, err = c.Write(data)
-	}
-
-	return nil
+	return s.flush(c, ctx)
 }
@@ -42,6 +42,10 @@ func (s *Server) onTcpData(ctx *connContext, c gnet.Conn) (action gnet.Action) {
 	}
 
 	for {
+		if paused, action2 := s.paused(ctx, c); paused {
+			return action2
+		}
+
 		needAck, frame, err := ctx.codec.Decode(c)
 		if err != nil {
 			if errors.Is(err, codec.ErrUnexpectedEOF) {
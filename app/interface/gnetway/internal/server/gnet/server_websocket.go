@@ -16,7 +16,14 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
+// onWebsocketData decodes the websocket messages read so far, their payloads make the
+// stream of frames in ctx.wsCodec.Conn. The frames are decoded from it one by one like the
+// ones of a tcp connection, what is left when paused waits there for resumeTraffic.
 func (s *Server) onWebsocketData(ctx *connContext, c gnet.Conn) (action gnet.Action) {
+	if paused, action2 := s.paused(ctx, c); paused {
+		return action2
+	}
+
 	ws := ctx.wsCodec
 	if ws.ReadBufferBytes(c) == gnet.Close {
 		return gnet.Close
@@ -26,21 +33,24 @@ func (s *Server) onWebsocketData(ctx *connContext, c gnet.Conn) (action gnet.Act
 		return
 	}
 
-	if ws.Buf.Len() <= 0 {
-		return gnet.None
-	}
-	messages, err := ws.Decode(c)
-	if err != nil {
-		return gnet.Close
-	}
-	if messages == nil {
-		return
+	if ws.Buf.Len() > 0 {
+		messages, err := ws.Decode(c)
+		if err != nil {
+			return gnet.Close
+		}
+		for _, message := range messages {
+			_, _ = ws.Conn.InboundBuffer.Write(message.Payload)
+		}
 	}
-	for _, message := range messages {
-		ws.Conn.Buffer = message.Payload
+
+	for {
+		if paused, action2 := s.paused(ctx, c); paused {
+			return action2
+		}
 
 		if ctx.codec == nil {
-			ctx.codec, err = codec.CreateCodec(&ctx.wsCodec.Conn)
+			var err error
+			ctx.codec, err = codec.CreateCodec(&ws.Conn)
 			if err != nil {
 				if errors.Is(err, codec.ErrUnexpectedEOF) {
 					return gnet.None
@@ -52,31 +62,20 @@ func (s *Server) onWebsocketData(ctx *connContext, c gnet.Conn) (action gnet.Act
 
 		needAck, frame, err := ctx.codec.Decode(&ws.Conn)
 		if err != nil {
+			if errors.Is(err, codec.ErrUnexpectedEOF) {
+				return gnet.None
+			}
 			logx.Errorf("conn(%s) frame is error: %v", c, err)
-			action = gnet.Close
-			return
+			return gnet.Close
 		} else if frame == nil {
-			logx.Debugf("conn(%s) frame is nil", c)
-			return
+			break
 		}
 
-		//msg2, ok := frame.(*mtproto.MTPRawMessage)
-		//if !ok {
-		//	logx.Errorf("conn(%s) recv error: msg2 not codec.MTPRawMessage type", c)
-		//	action = gnet.Close
-		//	return
-		//}
-		//
-		//logx.Infof("conn(%s) recv frame: %s", c, msg2)
-
 		action = s.onMTPRawMessage(ctx, c, int64(binary.LittleEndian.Uint64(frame)), needAck, frame)
 		if action == gnet.Close {
 			return
 		}
-
-		_, _ = ws.Conn.InboundBuffer.Write(ws.Conn.Buffer)
-		ws.Conn.Buffer = ws.Conn.Buffer[:0]
-
 	}
+
 	return gnet.None
 }
@@ -0,0 +1,47 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// onSessionStreamItem handles what a session node sends over its stream,
+// the same way as the unary gateway rpc would.
+func (s *Server) onSessionStreamItem(item *session.SessionStreamItem) {
+	ctx := context.Background()
+
+	switch v := item.Item.(type) {
+	case *session.SessionStreamItem_GatewayData:
+		_, _ = s.GatewaySendDataToGateway(ctx, &gateway.TLGatewaySendDataToGateway{
+			AuthKeyId: v.GatewayData.AuthKeyId,
+			SessionId: v.GatewayData.SessionId,
+			Payload:   v.GatewayData.Payload,
+		})
+	case *session.SessionStreamItem_InvalidateAuthKey:
+		_, _ = s.GatewayInvalidateAuthKey(ctx, &gateway.TLGatewayInvalidateAuthKey{
+			AuthKeyId: v.InvalidateAuthKey.AuthKeyId,
+			Destroyed: v.InvalidateAuthKey.Destroyed,
+		})
+	default:
+		logx.Errorf("session stream - unexpected item: %v", item)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2021-present,  Teamgram Studio (https://teamgram.io).
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gnet
+
+import (
+	"sync"
+)
+
+const (
+	wheelBits   = 6
+	wheelSlots  = 1 << wheelBits
+	wheelMask   = wheelSlots - 1
+	wheelLevels = 4
+	// wheelSpan is the farthest a deadline can be, in ticks, later ones fire then.
+	wheelSpan = 1<<(wheelBits*wheelLevels) - 1
+)
+
+type wheelEntry struct {
+	id       int64
+	deadline int64
+	level    int
+	slot     int
+}
+
+// timingWheel is a hierarchical timing wheel of ids, one deadline per id. A tick is a
+// unix second, level l holds the deadlines up to 64^(l+1) ticks away in 64 slots, they
+// cascade down a level every time the level below went round once. Adding, moving or
+// removing a deadline is O(1), a tick costs what fires and what cascades.
+type timingWheel struct {
+	mu      sync.Mutex
+	now     int64
+	entries map[int64]*wheelEntry
+	slots   [wheelLevels][wheelSlots]map[int64]*wheelEntry
+}
+
+func newTimingWheel(now int64) *timingWheel {
+	w := &timingWheel{
+		now:     now,
+		entries: make(map[int64]*wheelEntry),
+	}
+	for l := range w.slots {
+		for i := range w.slots[l] {
+			w.slots[l][i] = make(map[int64]*wheelEntry)
+		}
+	}
+
+	return w
+}
+
+// Set makes id fire at deadline, instead of when it was to fire before. A deadline
+// that passed fires on the next tick.
+func (w *timingWheel) Set(id, deadline int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	e, ok := w.entries[id]
+	if ok {
+		delete(w.slots[e.level][e.slot], id)
+	} else {
+		e = &wheelEntry{id: id}
+		w.entries[id] = e
+	}
+	if deadline <= w.now {
+		deadline = w.now + 1
+	}
+	e.deadline = deadline
+	w.place(e)
+}
+
+// Remove forgets id.
+func (w *timingWheel) Remove(id int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if e, ok := w.entries[id]; ok {
+		delete(w.slots[e.level][e.slot], id)
+		delete(w.entries, id)
+	}
+}
+
+// Len is the number of ids in the wheel.
+func (w *timingWheel) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return len(w.entries)
+}
+
+// Advance moves the wheel to now and returns the ids whose deadline is reached, they are
+// removed from the wheel.
+func (w *timingWheel) Advance(now int64) (fired []int64) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for w.now < now {
+		w.now++
+		for l := 1; l < wheelLevels; l++ {
+			if w.now&(1<<(wheelBits*l)-1) != 0 {
+				break
+			}
+			w.cascade(l, int(w.now>>(wheelBits*l))&wheelMask)
+		}
+
+		slot := w.slots[0][w.now&wheelMask]
+		for id := range slot {
+			fired = append(fired, id)
+			delete(slot, id)
+			delete(w.entries, id)
+		}
+	}
+
+	return
+}
+
+// cascade moves the deadlines of a slot of level l down to where they are due now.
+func (w *timingWheel) cascade(l, i int) {
+	slot := w.slots[l][i]
+	for id, e := range slot {
+		delete(slot, id)
+		w.place(e)
+	}
+}
+
+func (w *timingWheel) place(e *wheelEntry) {
+	delta := e.deadline - w.now
+	if delta < 0 {
+		delta = 0
+	} else if delta > wheelSpan {
+		delta = wheelSpan
+		e.deadline = w.now + delta
+	}
+
+	deadline := w.now + delta
+	e.level = 0
+	for delta >= 1<<(wheelBits*(e.level+1)) {
+		e.level++
+	}
+	e.slot = int(deadline>>(wheelBits*e.level)) & wheelMask
+	w.slots[e.level][e.slot][e.id] = e
+}
@@ -35,3 +35,82 @@ func (s *Service) GatewaySendDataToGateway(ctx context.Context, request *gateway
 	logx.WithContext(ctx).Debugf("gateway.sendDataToGateway - reply: %s", r)
 	return r, err
 }
+
+// GatewaySendDataToGatewayWithReceipt
+// gateway.sendDataToGatewayWithReceipt auth_key_id:long session_id:long payload:bytes timeout:int = Vector<DeliveryReceipt>;
+func (s *Service) GatewaySendDataToGatewayWithReceipt(ctx context.Context, request *gateway.TLGatewaySendDataToGatewayWithReceipt) (reply *gateway.Vector_DeliveryReceipt, err error) {
+	logx.WithContext(ctx).Debugf("gateway.sendDataToGatewayWithReceipt - request: {auth_key_id:%d, session_id:long:%d, payload: %d, timeout: %d}",
+		request.AuthKeyId,
+		request.SessionId,
+		len(request.Payload),
+		request.Timeout)
+
+	r, err := s.RPCGatewayServer.GatewaySendDataToGatewayWithReceipt(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	logx.WithContext(ctx).Debugf("gateway.sendDataToGatewayWithReceipt - reply: %s", r)
+	return r, err
+}
+
+// GatewaySendBatchDataToGateway
+// gateway.sendBatchDataToGateway items:Vector<GatewayData> timeout:int = Vector<GatewayDeliveryResult>;
+func (s *Service) GatewaySendBatchDataToGateway(ctx context.Context, request *gateway.TLGatewaySendBatchDataToGateway) (reply *gateway.Vector_GatewayDeliveryResult, err error) {
+	logx.WithContext(ctx).Debugf("gateway.sendBatchDataToGateway - request: {items: %d, timeout: %d}",
+		len(request.Items),
+		request.Timeout)
+
+	r, err := s.RPCGatewayServer.GatewaySendBatchDataToGateway(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	logx.WithContext(ctx).Debugf("gateway.sendBatchDataToGateway - reply: %s", r)
+	return r, err
+}
+
+// GatewayBroadcastDataToGateway
+// gateway.broadcastDataToGateway perm_auth_key_id:long body:bytes timeout:int = Vector<GatewayDeliveryResult>;
+func (s *Service) GatewayBroadcastDataToGateway(ctx context.Context, request *gateway.TLGatewayBroadcastDataToGateway) (reply *gateway.Vector_GatewayDeliveryResult, err error) {
+	logx.WithContext(ctx).Debugf("gateway.broadcastDataToGateway - request: {perm_auth_key_id: %d, body: %d, timeout: %d}",
+		request.PermAuthKeyId,
+		len(request.Body),
+		request.Timeout)
+
+	r, err := s.RPCGatewayServer.GatewayBroadcastDataToGateway(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	logx.WithContext(ctx).Debugf("gateway.broadcastDataToGateway - reply: %s", r)
+	return r, err
+}
+
+// GatewayInvalidateAuthKey
+// gateway.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (s *Service) GatewayInvalidateAuthKey(ctx context.Context, request *gateway.TLGatewayInvalidateAuthKey) (reply *mtproto.Bool, err error) {
+	logx.WithContext(ctx).Debugf("gateway.invalidateAuthKey - request: %s", request)
+
+	r, err := s.RPCGatewayServer.GatewayInvalidateAuthKey(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	logx.WithContext(ctx).Debugf("gateway.invalidateAuthKey - reply: %s", r)
+	return r, err
+}
+
+// GatewayDrainGateway
+// gateway.drainGateway window:int = Bool;
+func (s *Service) GatewayDrainGateway(ctx context.Context, request *gateway.TLGatewayDrainGateway) (reply *mtproto.Bool, err error) {
+	logx.WithContext(ctx).Debugf("gateway.drainGateway - request: %s", request)
+
+	r, err := s.RPCGatewayServer.GatewayDrainGateway(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	logx.WithContext(ctx).Debugf("gateway.drainGateway - reply: %s", r)
+	return r, err
+}
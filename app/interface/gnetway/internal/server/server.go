@@ -19,37 +19,92 @@
 package server
 
 import (
-	"flag"
-
+	gateway_client "github.com/teamgram/teamgram-server/v2/app/interface/gnetway/client"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/gnet"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/grpc"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/svc"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
 
-	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
-var (
-	configFile = flag.String("f", "etc/gateway.yaml", "the config file")
-)
+// Runner is a server of the gnetway next to the grpc gateway.RPCGateway, RunLoop runs
+// it and Destroy stops it.
+type Runner interface {
+	Run() error
+	Stop() error
+}
+
+// RunnerFactory makes the Runner of an initialized gnetway, a nil Runner is none.
+type RunnerFactory func(c config.Config, ctx *svc.ServiceContext, srv *gnet.Server) (Runner, error)
 
 type Server struct {
+	// grpcSrv serves gateway.RPCGateway, runner what WithRunner added, both go to server
 	grpcSrv *zrpc.RpcServer
+	runner  Runner
 	server  *gnet.Server
+	svcCtx  *svc.ServiceContext
+
+	configFile *string
+	// c and sessionClient are set when the session is hosted in the same process
+	c             *config.Config
+	sessionClient sessionclient.SessionClient
+	newRunner     RunnerFactory
+}
+
+// New creates the gnetway, configFile is read by Initialize, after the flags are parsed.
+func New(configFile *string) *Server {
+	return &Server{
+		configFile: configFile,
+	}
+}
+
+// NewInProcess creates a gnetway that calls the session hosted in the same process through
+// sessionClient. It serves neither grpc nor kitex, the session calls GatewayClient instead.
+func NewInProcess(c config.Config, sessionClient sessionclient.SessionClient) *Server {
+	return &Server{
+		c:             &c,
+		sessionClient: sessionClient,
+	}
+}
+
+// WithRunner has the gnetway serve what newRunner makes too. It is how cmd/gnetway-kitex
+// adds gnetway.RPCgnetway over kitex, the other builds don't link kitex in.
+func (s *Server) WithRunner(newRunner RunnerFactory) *Server {
+	s.newRunner = newRunner
+	return s
 }
 
 func (s *Server) Initialize() error {
 	var c config.Config
-	conf.MustLoad(*configFile, &c)
+	if s.c != nil {
+		c = *s.c
+	} else {
+		conf2.MustLoad(*s.configFile, &c)
+	}
 
 	logx.Infov(c)
 
-	ctx := svc.NewServiceContext(c)
+	ctx := svc.NewServiceContext(c, s.sessionClient)
+	s.svcCtx = ctx
 	s.server = gnet.New(ctx, c)
-	s.grpcSrv = grpc.New(ctx, c.RpcServerConf, s.server)
 
+	if s.sessionClient != nil {
+		return nil
+	}
+
+	if s.newRunner != nil {
+		runner, err := s.newRunner(c, ctx, s.server)
+		if err != nil {
+			return err
+		}
+		s.runner = runner
+	}
+
+	s.grpcSrv = grpc.New(ctx, c.RpcServerConf, s.server)
 	go func() {
 		s.grpcSrv.Start()
 	}()
@@ -57,15 +112,38 @@ func (s *Server) Initialize() error {
 	return nil
 }
 
+// GatewayId is the server_id the gnetway reports to the session.
+func (s *Server) GatewayId() string {
+	return s.svcCtx.GatewayId
+}
+
+// GatewayClient calls the gnetway directly, without going over the network.
+func (s *Server) GatewayClient() gateway_client.GatewayClient {
+	return s.server
+}
+
 func (s *Server) RunLoop() {
-	// s.server.Serve()
-	//if err := s.server.Serve(); err != nil {
-	//	logx.Errorf("run server error: %v, quit...", err)
-	//	commands.GSignal <- syscall.SIGQUIT
-	//}
+	if s.runner == nil {
+		return
+	}
+
+	if err := s.runner.Run(); err != nil {
+		logx.Errorf("run server error: %v", err)
+	}
 }
 
+// Destroy drains the gnetway first, the sessions can still reach the connections being
+// drained meanwhile, then stops serving them.
 func (s *Server) Destroy() {
-	s.grpcSrv.Stop()
+	s.server.Drain(0)
+
+	if s.grpcSrv != nil {
+		s.grpcSrv.Stop()
+	}
+	if s.runner != nil {
+		if err := s.runner.Stop(); err != nil {
+			logx.Errorf("stop server error: %v", err)
+		}
+	}
 	s.server.Close()
 }
@@ -0,0 +1,47 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2024 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gnetway"
+
+	"github.com/cloudwego/kitex/pkg/klog"
+)
+
+// GnetwaySendDataToGateway
+// gnetway.sendDataToGateway auth_key_id:long session_id:long payload:bytes = Bool;
+func (s *Service) GnetwaySendDataToGateway(ctx context.Context, request *gnetway.TLGnetwaySendDataToGateway) (*tg.Bool, error) {
+	klog.Debugf("gnetway.sendDataToGateway - request: {auth_key_id:%d, session_id:%d, payload: %d}",
+		request.AuthKeyId,
+		request.SessionId,
+		len(request.Payload))
+
+	r, err := s.RPCGatewayServer.GatewaySendDataToGateway(ctx, &gateway.TLGatewaySendDataToGateway{
+		Constructor: gateway.CRC32_gateway_sendDataToGateway,
+		AuthKeyId:   request.AuthKeyId,
+		SessionId:   request.SessionId,
+		Payload:     request.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Debugf("gnetway.sendDataToGateway - reply: %s", r)
+	if mtproto.FromBool(r) {
+		return tg.BoolTrue, nil
+	}
+	return tg.BoolFalse, nil
+}
@@ -0,0 +1,51 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package service
+
+import (
+	"net"
+
+	"github.com/teamgram/proto/v2/rpc/codec"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gnetway/gnetwayservice"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/server/gnet"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/svc"
+
+	kitexserver "github.com/cloudwego/kitex/server"
+)
+
+// NewRunner serves gnetway.RPCgnetway over kitex on c.Kitex.ListenOn, it is a
+// server.RunnerFactory. There is none with Kitex.Disable.
+func NewRunner(c config.Config, ctx *svc.ServiceContext, srv *gnet.Server) (server.Runner, error) {
+	if c.Kitex.Disable {
+		return nil, nil
+	}
+
+	opts := []kitexserver.Option{kitexserver.WithCodec(codec.NewZRpcCodec(true))}
+	if c.Kitex.ListenOn != "" {
+		addr, err := net.ResolveTCPAddr("tcp", c.Kitex.ListenOn)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, kitexserver.WithServiceAddr(addr))
+	}
+
+	return gnetwayservice.NewServer(New(ctx, srv), opts...), nil
+}
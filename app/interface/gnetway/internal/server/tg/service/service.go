@@ -0,0 +1,33 @@
+/*
+ * WARNING! All changes made in this file will be lost!
+ * Created from 'scheme.tl' by 'mtprotoc'
+ *
+ * Copyright 2024 Teamgram Authors.
+ *  All rights reserved.
+ *
+ * Author: teamgramio (teamgram.io@gmail.com)
+ */
+
+package service
+
+import (
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/svc"
+)
+
+// Service serves gnetway.RPCgnetway over kitex with the handlers of the grpc gateway.RPCGateway.
+type Service struct {
+	svcCtx *svc.ServiceContext
+	gateway.RPCGatewayServer
+}
+
+func (s *Service) GetServiceContext() *svc.ServiceContext {
+	return s.svcCtx
+}
+
+func New(ctx *svc.ServiceContext, srv gateway.RPCGatewayServer) *Service {
+	return &Service{
+		svcCtx:           ctx,
+		RPCGatewayServer: srv,
+	}
+}
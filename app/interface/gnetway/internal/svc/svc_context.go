@@ -15,6 +15,7 @@ import (
 
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/config"
 	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/internal/dao"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 
 	"github.com/zeromicro/go-zero/core/netx"
 )
@@ -53,10 +54,14 @@ type ServiceContext struct {
 	GatewayId string
 }
 
-func NewServiceContext(c config.Config) *ServiceContext {
+// NewServiceContext creates the service context, sessionClient is the session hosted in
+// the same process, nil means the session nodes found by c.Session.
+func NewServiceContext(c config.Config, sessionClient sessionclient.SessionClient) *ServiceContext {
+	gatewayId := figureOutListenOn(c.ListenOn)
+
 	return &ServiceContext{
 		Config:    c,
-		Dao:       dao.New(c),
-		GatewayId: figureOutListenOn(c.ListenOn),
+		Dao:       dao.New(c, gatewayId, sessionClient),
+		GatewayId: gatewayId,
 	}
 }
@@ -28,6 +28,9 @@ type SessionClient interface {
 	SessionPushUpdatesData(ctx context.Context, in *session.TLSessionPushUpdatesData) (*tg.Bool, error)
 	SessionPushSessionUpdatesData(ctx context.Context, in *session.TLSessionPushSessionUpdatesData) (*tg.Bool, error)
 	SessionPushRpcResultData(ctx context.Context, in *session.TLSessionPushRpcResultData) (*tg.Bool, error)
+	SessionInvalidateAuthKey(ctx context.Context, in *session.TLSessionInvalidateAuthKey) (*tg.Bool, error)
+	SessionBindTempAuthKey(ctx context.Context, in *session.TLSessionBindTempAuthKey) (*tg.Bool, error)
+	SessionQueryAuthKeyInfo(ctx context.Context, in *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error)
 }
 
 type defaultSessionClient struct {
@@ -93,3 +96,21 @@ func (m *defaultSessionClient) SessionPushSessionUpdatesData(ctx context.Context
 func (m *defaultSessionClient) SessionPushRpcResultData(ctx context.Context, in *session.TLSessionPushRpcResultData) (*tg.Bool, error) {
 	return m.cli.SessionPushRpcResultData(ctx, in)
 }
+
+// SessionInvalidateAuthKey
+// session.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (m *defaultSessionClient) SessionInvalidateAuthKey(ctx context.Context, in *session.TLSessionInvalidateAuthKey) (*tg.Bool, error) {
+	return m.cli.SessionInvalidateAuthKey(ctx, in)
+}
+
+// SessionBindTempAuthKey
+// session.bindTempAuthKey perm_auth_key_id:long temp_auth_key_id:long expires_at:long = Bool;
+func (m *defaultSessionClient) SessionBindTempAuthKey(ctx context.Context, in *session.TLSessionBindTempAuthKey) (*tg.Bool, error) {
+	return m.cli.SessionBindTempAuthKey(ctx, in)
+}
+
+// SessionQueryAuthKeyInfo
+// session.queryAuthKeyInfo auth_key_id:long = SessionAuthKeyInfo;
+func (m *defaultSessionClient) SessionQueryAuthKeyInfo(ctx context.Context, in *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error) {
+	return m.cli.SessionQueryAuthKeyInfo(ctx, in)
+}
@@ -32,6 +32,9 @@ type SessionClient interface {
 	SessionPushUpdatesData(ctx context.Context, in *session.TLSessionPushUpdatesData) (*mtproto.Bool, error)
 	SessionPushSessionUpdatesData(ctx context.Context, in *session.TLSessionPushSessionUpdatesData) (*mtproto.Bool, error)
 	SessionPushRpcResultData(ctx context.Context, in *session.TLSessionPushRpcResultData) (*mtproto.Bool, error)
+	SessionInvalidateAuthKey(ctx context.Context, in *session.TLSessionInvalidateAuthKey) (*mtproto.Bool, error)
+	SessionBindTempAuthKey(ctx context.Context, in *session.TLSessionBindTempAuthKey) (*mtproto.Bool, error)
+	SessionQueryAuthKeyInfo(ctx context.Context, in *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error)
 }
 
 type defaultSessionClient struct {
@@ -142,3 +145,36 @@ func (m *defaultSessionClient) SessionPushRpcResultData(ctx context.Context, in
 	client := session.NewRPCSessionClient(m.cli.Conn())
 	return client.SessionPushRpcResultData(ctx, in)
 }
+
+// SessionInvalidateAuthKey
+// session.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (m *defaultSessionClient) SessionInvalidateAuthKey(ctx context.Context, in *session.TLSessionInvalidateAuthKey) (*mtproto.Bool, error) {
+	md := metadata.RpcMetadataFromIncoming(ctx)
+	if md != nil {
+		ctx, _ = metadata.RpcMetadataToOutgoing(ctx, md)
+	}
+	client := session.NewRPCSessionClient(m.cli.Conn())
+	return client.SessionInvalidateAuthKey(ctx, in)
+}
+
+// SessionBindTempAuthKey
+// session.bindTempAuthKey perm_auth_key_id:long temp_auth_key_id:long expires_at:long = Bool;
+func (m *defaultSessionClient) SessionBindTempAuthKey(ctx context.Context, in *session.TLSessionBindTempAuthKey) (*mtproto.Bool, error) {
+	md := metadata.RpcMetadataFromIncoming(ctx)
+	if md != nil {
+		ctx, _ = metadata.RpcMetadataToOutgoing(ctx, md)
+	}
+	client := session.NewRPCSessionClient(m.cli.Conn())
+	return client.SessionBindTempAuthKey(ctx, in)
+}
+
+// SessionQueryAuthKeyInfo
+// session.queryAuthKeyInfo auth_key_id:long = SessionAuthKeyInfo;
+func (m *defaultSessionClient) SessionQueryAuthKeyInfo(ctx context.Context, in *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error) {
+	md := metadata.RpcMetadataFromIncoming(ctx)
+	if md != nil {
+		ctx, _ = metadata.RpcMetadataToOutgoing(ctx, md)
+	}
+	client := session.NewRPCSessionClient(m.cli.Conn())
+	return client.SessionQueryAuthKeyInfo(ctx, in)
+}
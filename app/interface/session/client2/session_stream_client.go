@@ -0,0 +1,153 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sessionclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+const (
+	minStreamBackoff = 100 * time.Millisecond
+	maxStreamBackoff = 5 * time.Second
+)
+
+// sessionStreamClient sends createSession, sendDataToSession and closeSession over
+// the stream of a session node, the other methods stay unary calls. A call returns once
+// the session handled it, or codes.Unavailable if the stream can't get it there in time.
+type sessionStreamClient struct {
+	SessionClient
+	cli    zrpc.Client
+	link   *streamlink.Link
+	cancel context.CancelFunc
+}
+
+// NewSessionStreamClient opens the stream to the session node behind cli and keeps
+// reconnecting it until Close. serverId identifies the gnetway to the session,
+// handler receives what the session sends back.
+func NewSessionStreamClient(cli zrpc.Client, c streamlink.Config, serverId string, handler streamlink.Handler) SessionClient {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	m := &sessionStreamClient{
+		SessionClient: NewSessionClient(cli),
+		cli:           cli,
+		link:          streamlink.New(c, serverId, handler),
+		cancel:        cancel,
+	}
+	go m.connect(ctx)
+
+	return m
+}
+
+func (m *sessionStreamClient) connect(ctx context.Context) {
+	backoff := minStreamBackoff
+
+	for {
+		start := time.Now()
+		err := m.serve(ctx)
+
+		select {
+		case <-m.link.Done():
+			return
+		default:
+		}
+
+		// a stream that lived a while was fine, start over with a short backoff
+		if time.Since(start) > maxStreamBackoff {
+			backoff = minStreamBackoff
+		}
+		logx.Errorf("session stream - serve error: %v, reconnect in %s", err, backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-m.link.Done():
+			return
+		}
+
+		if backoff *= 2; backoff > maxStreamBackoff {
+			backoff = maxStreamBackoff
+		}
+	}
+}
+
+func (m *sessionStreamClient) serve(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := session.NewRPCSessionStreamClient(m.cli.Conn()).SessionStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	return m.link.Serve(stream, nil)
+}
+
+// Close stops the stream, frames not acked by the session yet are lost.
+func (m *sessionStreamClient) Close() error {
+	m.link.Close()
+	m.cancel()
+
+	return nil
+}
+
+// SessionCreateSession
+// session.createSession client:SessionClientEvent = Bool;
+func (m *sessionStreamClient) SessionCreateSession(ctx context.Context, in *session.TLSessionCreateSession) (*mtproto.Bool, error) {
+	err := m.link.Push(ctx, &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_CreateSession{CreateSession: in.Client},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
+
+// SessionSendDataToSession
+// session.sendDataToSession data:SessionClientData = Bool;
+func (m *sessionStreamClient) SessionSendDataToSession(ctx context.Context, in *session.TLSessionSendDataToSession) (*mtproto.Bool, error) {
+	err := m.link.Push(ctx, &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_SendData{SendData: in.Data},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
+
+// SessionCloseSession
+// session.closeSession client:SessionClientEvent = Bool;
+func (m *sessionStreamClient) SessionCloseSession(ctx context.Context, in *session.TLSessionCloseSession) (*mtproto.Bool, error) {
+	err := m.link.Push(ctx, &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_CloseSession{CloseSession: in.Client},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mtproto.BoolTrue, nil
+}
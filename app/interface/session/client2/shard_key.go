@@ -0,0 +1,39 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sessionclient
+
+import (
+	"strconv"
+)
+
+// ShardKey is the key every gnetway -> session call and every session -> session call
+// about an auth key is sharded by.
+//
+// A key is owned by its perm auth key: the session node owning the perm auth key keeps
+// the sessions and the key info of the perm key and of all the temp keys bound to it.
+// A temp key that is not bound yet, or whose binding the caller doesn't know, is owned by
+// itself. That node keeps its entry in the temp -> perm directory, so asked about a bound
+// temp key it finds the node of the perm key.
+func ShardKey(authKeyId, permAuthKeyId int64) string {
+	if permAuthKeyId != 0 {
+		return strconv.FormatInt(permAuthKeyId, 10)
+	}
+
+	return strconv.FormatInt(authKeyId, 10)
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sessionclient
+
+import (
+	"sort"
+
+	"github.com/zeromicro/go-zero/core/hash"
+)
+
+// ShardWeights weighs the session nodes by address, 1..100, a node not listed or listed
+// with 0 weighs hash.TopWeight.
+type ShardWeights map[string]int
+
+func (w ShardWeights) Weight(addr string) int {
+	if v, ok := w[addr]; ok && v > 0 {
+		return v
+	}
+
+	return hash.TopWeight
+}
+
+// NewShardRing builds the ring a ShardKey is looked up in. The gnetways and the session
+// nodes build it the same way from the same nodes and weights, so they agree on the owners.
+func NewShardRing(nodes []string, weights ShardWeights) *hash.ConsistentHash {
+	// the same order everywhere, the ring breaks hash collisions by it
+	nodes = append([]string(nil), nodes...)
+	sort.Strings(nodes)
+
+	ring := hash.NewConsistentHash()
+	for _, addr := range nodes {
+		ring.AddWithWeight(addr, weights.Weight(addr))
+	}
+
+	return ring
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package sessionclient
+
+import (
+	"testing"
+
+	"github.com/zeromicro/go-zero/core/hash"
+)
+
+func TestShardWeights(t *testing.T) {
+	w := ShardWeights{"a": 10, "b": 0}
+
+	cases := map[string]int{
+		"a": 10,
+		"b": hash.TopWeight,
+		"c": hash.TopWeight,
+	}
+	for addr, want := range cases {
+		if got := w.Weight(addr); got != want {
+			t.Errorf("Weight(%s) = %d, want %d", addr, got, want)
+		}
+	}
+}
+
+func TestNewShardRing(t *testing.T) {
+	var (
+		weights = ShardWeights{"10.0.0.1:20450": 20}
+		r1      = NewShardRing([]string{"10.0.0.1:20450", "10.0.0.2:20450", "10.0.0.3:20450"}, weights)
+		r2      = NewShardRing([]string{"10.0.0.3:20450", "10.0.0.1:20450", "10.0.0.2:20450"}, weights)
+		owned   = make(map[string]int)
+	)
+
+	for id := int64(1); id <= 10000; id++ {
+		key := ShardKey(id, 0)
+		n1, _ := r1.Get(key)
+		n2, _ := r2.Get(key)
+		if n1 != n2 {
+			t.Fatalf("key %s: %v in one ring, %v in the other", key, n1, n2)
+		}
+		owned[n1.(string)]++
+	}
+
+	// 20 against 100, the light node owns much less
+	if light, heavy := owned["10.0.0.1:20450"], owned["10.0.0.2:20450"]; light*2 >= heavy {
+		t.Fatalf("weighted node owns %d keys, a full one %d", light, heavy)
+	}
+}
@@ -11,11 +11,15 @@
 package main
 
 import (
+	"flag"
+
 	"github.com/teamgram/marmota/pkg/commands"
 
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/server"
 )
 
+var configFile = flag.String("f", "etc/session.yaml", "the config file")
+
 func main() {
-	commands.Run(server.New())
+	commands.Run(server.New(configFile))
 }
@@ -0,0 +1,77 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package discovery finds the session nodes: in etcd, in a static list or in a file.
+// Local development, CI and small installs don't need to run etcd.
+package discovery
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+const (
+	filePrefix = "file://"
+)
+
+var (
+	ErrNoEndpoints = errors.New("discovery: neither Endpoints, a file:// Target nor Etcd configured")
+)
+
+// Subscriber knows the current members and calls the listeners whenever they change,
+// *discov.Subscriber is one.
+type Subscriber interface {
+	AddListener(listener func())
+	Values() []string
+}
+
+// NewSubscriber picks the discovery of c in the order zrpc does: Endpoints is a static
+// list, a Target of file:///path is a file with one endpoint per line that is watched
+// for changes, else the members registered in Etcd.
+func NewSubscriber(c zrpc.RpcClientConf) (Subscriber, error) {
+	switch {
+	case len(c.Endpoints) > 0:
+		return NewStaticSubscriber(c.Endpoints), nil
+	case strings.HasPrefix(c.Target, filePrefix):
+		return NewFileSubscriber(strings.TrimPrefix(c.Target, filePrefix))
+	case len(c.Etcd.Hosts) > 0 && len(c.Etcd.Key) > 0:
+		return newEtcdSubscriber(c.Etcd)
+	default:
+		return nil, ErrNoEndpoints
+	}
+}
+
+func newEtcdSubscriber(c discov.EtcdConf) (Subscriber, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	if c.HasAccount() {
+		discov.RegisterAccount(c.Hosts, c.User, c.Pass)
+	}
+	if c.HasTLS() {
+		if err := discov.RegisterTLS(c.Hosts, c.CertFile, c.CertKeyFile, c.CACertFile, c.InsecureSkipVerify); err != nil {
+			return nil, err
+		}
+	}
+
+	return discov.NewSubscriber(c.Hosts, c.Key)
+}
@@ -0,0 +1,128 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package discovery
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	fileCheckInterval = 2 * time.Second
+)
+
+// fileSubscriber reads the endpoints from a file, one per line, # starts a comment.
+// The file is checked every fileCheckInterval, the listeners are called when it changed.
+type fileSubscriber struct {
+	path string
+
+	mu        sync.Mutex
+	content   []byte
+	values    []string
+	listeners []func()
+}
+
+func NewFileSubscriber(path string) (Subscriber, error) {
+	s := &fileSubscriber{
+		path: path,
+	}
+	if _, err := s.load(); err != nil {
+		return nil, err
+	}
+	go s.watch()
+
+	return s, nil
+}
+
+func (s *fileSubscriber) AddListener(listener func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.listeners = append(s.listeners, listener)
+}
+
+func (s *fileSubscriber) Values() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]string(nil), s.values...)
+}
+
+// load reads the file, changed is true if the content is not what we had.
+func (s *fileSubscriber) load() (changed bool, err error) {
+	content, err := os.ReadFile(s.path)
+	if err != nil {
+		return false, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.content != nil && bytes.Equal(content, s.content) {
+		return false, nil
+	}
+
+	var values []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.IndexByte(line, '#'); i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); len(line) > 0 {
+			values = append(values, line)
+		}
+	}
+
+	s.content = content
+	s.values = values
+
+	return true, nil
+}
+
+func (s *fileSubscriber) watch() {
+	ticker := time.NewTicker(fileCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		changed, err := s.load()
+		if err != nil {
+			// keep the last endpoints, the file may be being replaced
+			logx.Errorf("discovery - read %s error: %v", s.path, err)
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		s.mu.Lock()
+		listeners := append([]func(){}, s.listeners...)
+		s.mu.Unlock()
+
+		for _, listener := range listeners {
+			listener()
+		}
+	}
+}
@@ -19,9 +19,244 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
+	"github.com/teamgram/teamgram-server/v2/pkg/dcoption"
+
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
+// Config of the session, it serves the same handlers over grpc on ListenOn and over kitex
+// on Kitex.ListenOn. grpc is off without a ListenOn, kitex with Kitex.Disable.
 type Config struct {
 	zrpc.RpcServerConf
+	Kitex KitexServerConf `json:",optional"`
+	// Stream tunes the streams the gnetways open to us
+	Stream  streamlink.Config
+	Handoff HandoffConfig
+	// Peers finds the other session nodes, the gnetways must use the same list.
+	// Endpoints or a file:// Target work without etcd, each node must be listed
+	// with its ListenOn. Not set means the nodes registered in Etcd.
+	Peers zrpc.RpcClientConf `json:",optional"`
+	// PeerWeights weighs the Peers by address, 1..100, it must be SessionSharding.Weights
+	// of the gnetways
+	PeerWeights map[string]int `json:",optional"`
+
+	// the sections below may be left out, every field has a default, Help without
+	// Listeners leaves the help methods to the upstream
+	Queue    QueueConfig
+	Upstream UpstreamConfig
+	Help     HelpConfig
+	Push     PushConfig
+}
+
+// KitexServerConf is the kitex listener, an empty ListenOn is the kitex default 0.0.0.0:8888.
+type KitexServerConf struct {
+	ListenOn string `json:",optional"`
+	Disable  bool   `json:",optional"`
+}
+
+// QueueConfig limits what a session keeps for its client, MaxInflightRpcs is how many
+// of its rpc requests wait for their result at once, the next ones wait for their turn.
+type QueueConfig struct {
+	MaxInflightRpcs int `json:",default=128"`
+}
+
+// UpstreamConfig routes the rpc requests of the clients, the first route whose Method
+// matches wins. Method is a method name, a namespace like messages.* or * for the rest.
+type UpstreamConfig struct {
+	Timeout time.Duration   `json:",default=15s"`
+	Routes  []UpstreamRoute `json:",optional"`
+}
+
+// UpstreamRoute sends Method to Service, a zrpc backend, or to Local, the name of a
+// backend registered in process, e.g. local handlers or a kitex client.
+type UpstreamRoute struct {
+	Method  string
+	Service zrpc.RpcClientConf `json:",optional"`
+	Local   string             `json:",optional"`
+}
+
+func (r UpstreamRoute) HasService() bool {
+	return len(r.Service.Endpoints) > 0 || r.Service.Target != "" || len(r.Service.Etcd.Hosts) > 0
+}
+
+// HelpConfig is what the session answers help.getConfig and help.getNearestDc with, without
+// asking the upstream. Listeners are the gnetway listeners the way the gnetways define them
+// in Gnetway.Server, their tcp addresses are the dc_options. Without Listeners the session
+// doesn't answer them, they go upstream like the other methods.
+type HelpConfig struct {
+	ThisDc    int32               `json:",default=1"`
+	Country   string              `json:",default=US"`
+	Expires   time.Duration       `json:",default=1h"`
+	Listeners []dcoption.Listener `json:",optional"`
+	Limits    HelpLimits
+	Features  HelpFeatures
+}
+
+// HelpLimits are the limits and timeouts of help.getConfig, the defaults are telegram's.
+type HelpLimits struct {
+	ChatSizeMax             int32 `json:",default=200"`
+	MegagroupSizeMax        int32 `json:",default=200000"`
+	ForwardedCountMax       int32 `json:",default=100"`
+	OnlineUpdatePeriodMs    int32 `json:",default=210000"`
+	OfflineBlurTimeoutMs    int32 `json:",default=5000"`
+	OfflineIdleTimeoutMs    int32 `json:",default=30000"`
+	OnlineCloudTimeoutMs    int32 `json:",default=300000"`
+	NotifyCloudDelayMs      int32 `json:",default=30000"`
+	NotifyDefaultDelayMs    int32 `json:",default=1500"`
+	PushChatPeriodMs        int32 `json:",default=60000"`
+	PushChatLimit           int32 `json:",default=2"`
+	EditTimeLimit           int32 `json:",default=172800"`
+	RevokeTimeLimit         int32 `json:",default=2147483647"`
+	RevokePmTimeLimit       int32 `json:",default=2147483647"`
+	RatingEDecay            int32 `json:",default=2419200"`
+	StickersRecentLimit     int32 `json:",default=200"`
+	ChannelsReadMediaPeriod int32 `json:",default=604800"`
+	CallReceiveTimeoutMs    int32 `json:",default=20000"`
+	CallRingTimeoutMs       int32 `json:",default=90000"`
+	CallConnectTimeoutMs    int32 `json:",default=30000"`
+	CallPacketTimeoutMs     int32 `json:",default=10000"`
+	CaptionLengthMax        int32 `json:",default=1024"`
+	MessageLengthMax        int32 `json:",default=4096"`
+	SavedGifsLimit          int32 `json:",default=200"`
+	StickersFavedLimit      int32 `json:",default=5"`
+	PinnedDialogsCountMax   int32 `json:",default=5"`
+	PinnedInfolderCountMax  int32 `json:",default=100"`
+}
+
+// HelpFeatures are the flags of help.getConfig.
+type HelpFeatures struct {
+	DefaultP2PContacts      bool   `json:",optional"`
+	PreloadFeaturedStickers bool   `json:",optional"`
+	RevokePmInbox           bool   `json:",optional"`
+	BlockedMode             bool   `json:",optional"`
+	ForceTryIpv6            bool   `json:",optional"`
+	PhonecallsEnabled       bool   `json:",optional"`
+	IgnorePhoneEntities     bool   `json:",optional"`
+	PfsEnabled              bool   `json:",optional"`
+	MeUrlPrefix             string `json:",default=https://t.me/"`
+	DcTxtDomainName         string `json:",optional"`
+}
+
+// PushConfig is where the updates flagged notification go when no session of the client
+// got them, File appends them as json lines, QueueSize of them wait for the disk.
+// No File drops them. A session got the updates once its gnetway wrote them to a
+// connection within DeliveryTimeout.
+type PushConfig struct {
+	File            string        `json:",optional"`
+	QueueSize       int           `json:",default=4096"`
+	DeliveryTimeout time.Duration `json:",default=3s"`
+}
+
+// HandoffConfig tunes how the state of the auth keys moves to their new session node,
+// BatchSize keys per chunk, the calls still reaching us are forwarded for ForwardFor.
+type HandoffConfig struct {
+	BatchSize  int           `json:",default=128"`
+	ForwardFor time.Duration `json:",default=2m"`
+}
+
+// Validate checks what the session can't start without, conf2.Load calls it.
+func (c Config) Validate() error {
+	if err := c.RpcServerConf.Validate(); err != nil {
+		return err
+	}
+
+	if c.ListenOn == "" && c.Kitex.Disable {
+		return errors.New("no listener, set ListenOn or enable Kitex")
+	}
+	if !c.Kitex.Disable && c.Kitex.ListenOn != "" {
+		if _, err := net.ResolveTCPAddr("tcp", c.Kitex.ListenOn); err != nil {
+			return fmt.Errorf("Kitex.ListenOn: %v", err)
+		}
+		if conf2.ListenConflict(c.Kitex.ListenOn, c.ListenOn) {
+			return fmt.Errorf("Kitex.ListenOn %s takes the port of ListenOn %s", c.Kitex.ListenOn, c.ListenOn)
+		}
+	}
+
+	if c.Queue.MaxInflightRpcs <= 0 {
+		return errors.New("Queue.MaxInflightRpcs must be positive")
+	}
+
+	if c.Push.QueueSize <= 0 {
+		return errors.New("Push.QueueSize must be positive")
+	}
+	if c.Push.DeliveryTimeout < time.Millisecond {
+		return errors.New("Push.DeliveryTimeout must be at least 1ms")
+	}
+
+	if c.Handoff.BatchSize <= 0 {
+		return errors.New("Handoff.BatchSize must be positive")
+	}
+
+	if err := c.Upstream.Validate(); err != nil {
+		return err
+	}
+
+	return c.Help.Validate()
+}
+
+// Enabled reports whether the session answers the help methods itself.
+func (c HelpConfig) Enabled() bool {
+	return len(c.Listeners) > 0
+}
+
+func (c HelpConfig) Validate() error {
+	if c.Expires <= 0 {
+		return errors.New("Help.Expires must be positive")
+	}
+	if !c.Enabled() {
+		return nil
+	}
+
+	options, err := dcoption.DcOptions(c.Listeners)
+	if err != nil {
+		return fmt.Errorf("Help.Listeners%v", err)
+	}
+	for _, o := range options {
+		if o.Id == c.ThisDc {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Help.Listeners: no tcp listener of dc %d", c.ThisDc)
+}
+
+func (c UpstreamConfig) Validate() error {
+	if c.Timeout <= 0 {
+		return errors.New("Upstream.Timeout must be positive")
+	}
+
+	methods := make(map[string]struct{}, len(c.Routes))
+	for i, r := range c.Routes {
+		if !IsMethodPattern(r.Method) {
+			return fmt.Errorf("Upstream.Routes[%d]: invalid Method %q", i, r.Method)
+		}
+		if _, ok := methods[r.Method]; ok {
+			return fmt.Errorf("Upstream.Routes[%d]: Method %s routed twice", i, r.Method)
+		}
+		methods[r.Method] = struct{}{}
+
+		if r.HasService() == (r.Local != "") {
+			return fmt.Errorf("Upstream.Routes[%d]: %s needs either a Service or a Local backend", i, r.Method)
+		}
+	}
+
+	return nil
+}
+
+// IsMethodPattern reports whether p is *, a namespace like messages.* or a method name.
+func IsMethodPattern(p string) bool {
+	if p == "*" {
+		return true
+	}
+
+	name := strings.TrimSuffix(p, ".*")
+	return name != "" && !strings.ContainsAny(name, "* ")
 }
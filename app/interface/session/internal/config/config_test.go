@@ -0,0 +1,91 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
+)
+
+func loadConfig(t *testing.T, content string) (Config, error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "session.yaml")
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var c Config
+	err := conf2.Load(file, &c)
+	return c, err
+}
+
+// TestLoadMinimal loads a config of only what has no default, the way allinone has it.
+func TestLoadMinimal(t *testing.T) {
+	c, err := loadConfig(t, "Name: session\nListenOn: 127.0.0.1:20120\n")
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	if c.Help.Enabled() {
+		t.Errorf("the help methods are answered without Help.Listeners")
+	}
+	if c.Queue.MaxInflightRpcs != 128 || c.Upstream.Timeout <= 0 || c.Push.QueueSize != 4096 || c.Help.Expires <= 0 {
+		t.Errorf("defaults not set: %+v %+v %+v %+v", c.Queue, c.Upstream, c.Push, c.Help)
+	}
+}
+
+func TestLoadHelp(t *testing.T) {
+	const base = "Name: session\nListenOn: 127.0.0.1:20120\n"
+
+	cases := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			"tcp listener of this dc",
+			base + "Help:\n  ThisDc: 2\n  Listeners:\n    - Proto: tcp\n      Addresses: [0.0.0.0:10443]\n      DcId: 2\n      IPs: [192.0.2.1]\n",
+			"",
+		},
+		{
+			"no tcp listener of this dc",
+			base + "Help:\n  ThisDc: 2\n  Listeners:\n    - Proto: websocket\n      Addresses: [0.0.0.0:11443]\n      DcId: 2\n",
+			"Help.Listeners: no tcp listener of dc 2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, err := loadConfig(t, tc.content)
+			switch {
+			case tc.wantErr == "" && err != nil:
+				t.Fatalf("Load() error: %v", err)
+			case tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)):
+				t.Fatalf("Load() error = %v, want %q", err, tc.wantErr)
+			case err == nil && !c.Help.Enabled():
+				t.Errorf("the help methods go upstream with Help.Listeners")
+			}
+		})
+	}
+}
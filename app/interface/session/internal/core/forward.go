@@ -0,0 +1,112 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/v2/bin"
+	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// forward hands a call about an auth key handed off to another session node over to it,
+// it returns false if the key is still ours. The call may run later, after the state of
+// the key arrived there, so it must not use c.ctx.
+func (c *SessionCore) forward(method string, authKeyId, permAuthKeyId int64, call func(ctx context.Context, cli sessionclient.SessionClient) error) bool {
+	return c.svcCtx.Forward(authKeyId, permAuthKeyId, func(cli sessionclient.SessionClient) {
+		if err := call(context.Background(), cli); err != nil {
+			logx.Errorf("%s - forward auth_key_id(%d) error: %v", method, authKeyId, err)
+		}
+	})
+}
+
+func toSessionClientEvent2(v *session.TLSessionClientEvent) *session2.SessionClientEvent {
+	return session2.MakeTLSessionClientEvent(&session2.SessionClientEvent{
+		ServerId:      v.ServerId,
+		ConnType:      v.ConnType,
+		AuthKeyId:     v.AuthKeyId,
+		KeyType:       v.KeyType,
+		PermAuthKeyId: v.PermAuthKeyId,
+		SessionId:     v.SessionId,
+		ClientIp:      v.ClientIp,
+	}).To_SessionClientEvent()
+}
+
+func toSessionClientData2(v *session.TLSessionClientData) *session2.SessionClientData {
+	return session2.MakeTLSessionClientData(&session2.SessionClientData{
+		ServerId:      v.ServerId,
+		ConnType:      v.ConnType,
+		AuthKeyId:     v.AuthKeyId,
+		KeyType:       v.KeyType,
+		PermAuthKeyId: v.PermAuthKeyId,
+		SessionId:     v.SessionId,
+		ClientIp:      v.ClientIp,
+		QuickAck:      v.QuickAck,
+		Salt:          v.Salt,
+		Payload:       v.Payload,
+	}).To_SessionClientData()
+}
+
+func toAuthKeyInfo2(v *tg.TLAuthKeyInfo) *mtproto.AuthKeyInfo {
+	return mtproto.MakeTLAuthKeyInfo(&mtproto.AuthKeyInfo{
+		AuthKeyId:          v.AuthKeyId,
+		AuthKey:            v.AuthKey,
+		AuthKeyType:        v.AuthKeyType,
+		PermAuthKeyId:      v.PermAuthKeyId,
+		TempAuthKeyId:      v.TempAuthKeyId,
+		MediaTempAuthKeyId: v.MediaTempAuthKeyId,
+	}).To_AuthKeyInfo()
+}
+
+func fromAuthKeyInfo2(v *mtproto.AuthKeyInfo) *tg.AuthKeyInfo {
+	return tg.MakeAuthKeyInfo(&tg.TLAuthKeyInfo{
+		ClazzID:            tg.ClazzID_authKeyInfo,
+		AuthKeyId:          v.AuthKeyId,
+		AuthKey:            v.AuthKey,
+		AuthKeyType:        v.AuthKeyType,
+		PermAuthKeyId:      v.PermAuthKeyId,
+		TempAuthKeyId:      v.TempAuthKeyId,
+		MediaTempAuthKeyId: v.MediaTempAuthKeyId,
+	})
+}
+
+func toUpdates2(v *tg.Updates) (*mtproto.Updates, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	x := bin.NewEncoder()
+	defer x.End()
+	if err := v.Encode(x, 0); err != nil {
+		return nil, err
+	}
+
+	updates := new(mtproto.Updates)
+	if err := updates.Decode(mtproto.NewDecodeBuf(x.Bytes())); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/dao"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+)
+
+// LocalMethods are the rpcs the session answers itself, they never reach the upstream.
+var LocalMethods = []string{
+	"help.getConfig",
+	"help.getNearestDc",
+}
+
+// LocalBackend serves LocalMethods.
+func LocalBackend(svcCtx *svc.ServiceContext) dao.Backend {
+	return dao.BackendFunc(func(ctx context.Context, req *dao.RpcRequest) (mtproto.TLObject, error) {
+		c := New(ctx, svcCtx)
+
+		switch in := req.Object.(type) {
+		case *mtproto.TLHelpGetConfig:
+			return c.HelpGetConfig(in)
+		case *mtproto.TLHelpGetNearestDc:
+			return c.HelpGetNearestDc(in)
+		default:
+			return nil, mtproto.ErrMethodNotImpl
+		}
+	})
+}
+
+// SetupInvoker plugs the session into the invoker of svcCtx: the results go back to the
+// clients, LocalMethods are answered here and the session follows the logouts and bindings.
+func SetupInvoker(svcCtx *svc.ServiceContext) {
+	svcCtx.SetRpcResultHandler(PushRpcResult(svcCtx))
+	svcCtx.RegisterDone("auth.logOut", LogOut(svcCtx))
+	svcCtx.RegisterDone("auth.bindTempAuthKey", BindTempAuthKey(svcCtx))
+
+	// without Help.Listeners the help methods go upstream like the others
+	if !svcCtx.HelpEnabled() {
+		return
+	}
+	local := LocalBackend(svcCtx)
+	for _, method := range LocalMethods {
+		svcCtx.RegisterMethod(method, local)
+	}
+}
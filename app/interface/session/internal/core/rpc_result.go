@@ -0,0 +1,82 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/dao"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// PushRpcResult hands the results of the upstream to the session of the request,
+// the same path as session.pushRpcResultData.
+func PushRpcResult(svcCtx *svc.ServiceContext) dao.RpcResultHandler {
+	return func(ctx context.Context, md *metadata.RpcMetadata, result []byte) {
+		_, err := New(ctx, svcCtx).SessionPushRpcResultData(&session.TLSessionPushRpcResultData{
+			ClazzID:        session.ClazzID_session_pushRpcResultData,
+			PermAuthKeyId:  md.PermAuthKeyId,
+			AuthKeyId:      md.AuthId,
+			SessionId:      md.SessionId,
+			ClientReqMsgId: md.ClientMsgId,
+			RpcResultData:  result,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("push the rpc result of msg_id %d error: %v", md.ClientMsgId, err)
+		}
+	}
+}
+
+// LogOut makes the gnetways drop the auth key of the client once auth.logOut succeeded,
+// after its result went out.
+func LogOut(svcCtx *svc.ServiceContext) dao.RpcDoneHandler {
+	return func(ctx context.Context, md *metadata.RpcMetadata, req, reply mtproto.TLObject) {
+		authKeyId := md.PermAuthKeyId
+		if authKeyId == 0 {
+			authKeyId = md.AuthId
+		}
+
+		New(ctx, svcCtx).invalidateAuthKey(authKeyId, false)
+	}
+}
+
+// BindTempAuthKey records the binding once auth.bindTempAuthKey succeeded, md.AuthId is
+// the temp key. Its key info moves to the node of the perm key, so do its live sessions.
+func BindTempAuthKey(svcCtx *svc.ServiceContext) dao.RpcDoneHandler {
+	return func(ctx context.Context, md *metadata.RpcMetadata, req, reply mtproto.TLObject) {
+		bind, ok := req.(*mtproto.TLAuthBindTempAuthKey)
+		if !ok {
+			return
+		}
+		if r, ok := reply.(*mtproto.Bool); ok && !mtproto.FromBool(r) {
+			return
+		}
+
+		err := svcCtx.BindTempAuthKey(ctx, bind.PermAuthKeyId, md.AuthId, int64(bind.ExpiresAt))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("bind temp auth_key_id(%d) to perm auth_key_id(%d) error: %v", md.AuthId, bind.PermAuthKeyId, err)
+		}
+		svcCtx.RebindSessions(md.AuthId, bind.PermAuthKeyId)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// SessionBindTempAuthKey
+// session.bindTempAuthKey perm_auth_key_id:long temp_auth_key_id:long expires_at:long = Bool;
+func (c *SessionCore) SessionBindTempAuthKey(in *session.TLSessionBindTempAuthKey) (*tg.Bool, error) {
+	// we own the temp key, the node of the perm key processed auth.bindTempAuthKey
+	err := c.svcCtx.BindLocal(c.ctx, in.PermAuthKeyId, in.TempAuthKeyId, in.ExpiresAt)
+	if err != nil {
+		// bound anyway, the key info stays here
+		logx.WithContext(c.ctx).Errorf("session.bindTempAuthKey - hand temp auth_key_id(%d) over error: %v", in.TempAuthKeyId, err)
+	}
+
+	return tg.BoolTrue, nil
+}
@@ -19,10 +19,12 @@
 package core
 
 import (
-	"errors"
+	"context"
 
 	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
 )
 
 var _ *tg.Bool
@@ -30,8 +32,22 @@ var _ *tg.Bool
 // SessionCloseSession
 // session.closeSession client:SessionClientEvent = Bool;
 func (c *SessionCore) SessionCloseSession(in *session.TLSessionCloseSession) (*tg.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.closeSession blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.Client != nil {
+		if client, ok := in.Client.ToSessionClientEvent(); ok {
+			forwarded := c.forward("session.closeSession", client.AuthKeyId, client.PermAuthKeyId, func(ctx context.Context, cli sessionclient.SessionClient) error {
+				_, err := cli.SessionCloseSession(ctx, &session2.TLSessionCloseSession{
+					Client: toSessionClientEvent2(client),
+				})
+				return err
+			})
+			if forwarded {
+				return tg.BoolTrue, nil
+			}
 
-	return nil, errors.New("session.closeSession not implemented")
+			c.svcCtx.RemoveAuthKeyGateway(client.AuthKeyId, client.ServerId)
+			c.svcCtx.CloseSession(client.PermAuthKeyId, client.AuthKeyId, client.SessionId)
+		}
+	}
+
+	return tg.BoolTrue, nil
 }
@@ -0,0 +1,43 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package core
+
+import (
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+)
+
+// SessionInvalidateAuthKey
+// session.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (c *SessionCore) SessionInvalidateAuthKey(in *session.TLSessionInvalidateAuthKey) (*tg.Bool, error) {
+	c.invalidateAuthKey(in.AuthKeyId, in.Destroyed)
+
+	return tg.BoolTrue, nil
+}
+
+// invalidateAuthKey makes every gnetway still holding authKeyId drop it, destroy_auth_key,
+// auth.logOut and auth.resetAuthorizations all end here.
+func (c *SessionCore) invalidateAuthKey(authKeyId int64, destroyed bool) {
+	c.svcCtx.InvalidateAuthKey(c.ctx, authKeyId, destroyed)
+	c.svcCtx.RemoveLiveSessions(authKeyId)
+	if destroyed {
+		c.svcCtx.RemoveBindings(authKeyId)
+		c.svcCtx.RemoveAuthKey(authKeyId)
+	}
+}
@@ -19,19 +19,55 @@
 package core
 
 import (
-	"errors"
+	"context"
 
+	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 var _ *tg.Bool
 
 // SessionPushRpcResultData
 // session.pushRpcResultData perm_auth_key_id:long auth_key_id:long session_id:long client_req_msg_id:long rpc_result_data:bytes = Bool;
+// It wraps the result in an rpc_result for the request client_req_msg_id and has the gnetway
+// of the session write it, boolFalse if the session has no connection anymore.
 func (c *SessionCore) SessionPushRpcResultData(in *session.TLSessionPushRpcResultData) (*tg.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.pushRpcResultData blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	forwarded := c.forward("session.pushRpcResultData", in.AuthKeyId, in.PermAuthKeyId, func(ctx context.Context, cli sessionclient.SessionClient) error {
+		_, err := cli.SessionPushRpcResultData(ctx, &session2.TLSessionPushRpcResultData{
+			PermAuthKeyId:  in.PermAuthKeyId,
+			AuthKeyId:      in.AuthKeyId,
+			SessionId:      in.SessionId,
+			ClientReqMsgId: in.ClientReqMsgId,
+			RpcResultData:  in.RpcResultData,
+		})
+		return err
+	})
+	if forwarded {
+		return tg.BoolTrue, nil
+	}
+
+	// rpc_result#f35c6d01 req_msg_id:long result:Object = RpcResult;
+	x := mtproto.NewEncodeBuf(12 + len(in.RpcResultData))
+	x.Int(int32(mtproto.CRC32_rpc_result))
+	x.Long(in.ClientReqMsgId)
+	x.Bytes(in.RpcResultData)
+
+	ok, err := c.svcCtx.SendToSession(c.ctx, in.PermAuthKeyId, in.AuthKeyId, in.SessionId, x.GetBuf(), true)
+	if err != nil {
+		logx.WithContext(c.ctx).Errorf("session.pushRpcResultData - auth_key_id(%d) session_id(%d) msg_id(%d) error: %v",
+			in.AuthKeyId, in.SessionId, in.ClientReqMsgId, err)
+		return nil, err
+	}
+	if !ok {
+		logx.WithContext(c.ctx).Infof("session.pushRpcResultData - auth_key_id(%d) session_id(%d) gone, drop the result of msg_id(%d)",
+			in.AuthKeyId, in.SessionId, in.ClientReqMsgId)
+		return tg.BoolFalse, nil
+	}
 
-	return nil, errors.New("session.pushRpcResultData not implemented")
+	return tg.BoolTrue, nil
 }
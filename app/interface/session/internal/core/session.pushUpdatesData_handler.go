@@ -19,19 +19,90 @@
 package core
 
 import (
-	"errors"
+	"context"
+	"time"
 
+	"github.com/teamgram/proto/v2/bin"
 	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/dao"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 var _ *tg.Bool
 
 // SessionPushUpdatesData
 // session.pushUpdatesData flags:# perm_auth_key_id:long notification:flags.0?true updates:Updates = Bool;
+// It is boolTrue if a session of the perm key got the updates, boolFalse tells the upstream
+// nobody online got them, the ones flagged notification go to the push sink then.
 func (c *SessionCore) SessionPushUpdatesData(in *session.TLSessionPushUpdatesData) (*tg.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.pushUpdatesData blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	forwarded := c.forward("session.pushUpdatesData", in.PermAuthKeyId, in.PermAuthKeyId, func(ctx context.Context, cli sessionclient.SessionClient) error {
+		updates, err := toUpdates2(in.Updates)
+		if err != nil {
+			return err
+		}
+		_, err = cli.SessionPushUpdatesData(ctx, &session2.TLSessionPushUpdatesData{
+			PermAuthKeyId: in.PermAuthKeyId,
+			Notification:  in.Notification,
+			Updates:       updates,
+		})
+		return err
+	})
+	if forwarded {
+		return tg.BoolTrue, nil
+	}
+
+	if in.Updates == nil {
+		return tg.BoolFalse, nil
+	}
+
+	deliveries := c.svcCtx.PushUpdates(c.ctx, in.PermAuthKeyId, func(layer int32) ([]byte, error) {
+		x := bin.NewEncoder()
+		defer x.End()
+
+		if err := in.Updates.Encode(x, layer); err != nil {
+			return nil, err
+		}
+		return x.Clone(), nil
+	})
+
+	delivered := 0
+	for _, v := range deliveries {
+		if v.Delivered {
+			delivered++
+		} else {
+			logx.WithContext(c.ctx).Infof("session.pushUpdatesData - perm_auth_key_id(%d) auth_key_id(%d) session_id(%d) on gateway(%s) not delivered: %v",
+				in.PermAuthKeyId, v.AuthKeyId, v.SessionId, v.ServerId, v.Err)
+		}
+	}
+	logx.WithContext(c.ctx).Debugf("session.pushUpdatesData - perm_auth_key_id(%d) delivered to %d of %d sessions",
+		in.PermAuthKeyId, delivered, len(deliveries))
+
+	if delivered == 0 {
+		if in.Notification {
+			c.pushOffline(in)
+		}
+		return tg.BoolFalse, nil
+	}
+
+	return tg.BoolTrue, nil
+}
+
+// pushOffline hands the updates to the push sink, with the device tokens of the perm key.
+func (c *SessionCore) pushOffline(in *session.TLSessionPushUpdatesData) {
+	updates, err := toUpdates2(in.Updates)
+	if err != nil {
+		logx.WithContext(c.ctx).Errorf("session.pushUpdatesData - perm_auth_key_id(%d) convert updates error: %v", in.PermAuthKeyId, err)
+		return
+	}
 
-	return nil, errors.New("session.pushUpdatesData not implemented")
+	c.svcCtx.PushOffline(c.ctx, &dao.PushNotification{
+		PermAuthKeyId: in.PermAuthKeyId,
+		Summary:       dao.SummarizeUpdates(updates),
+		DeviceTokens:  c.svcCtx.GetDeviceTokens(in.PermAuthKeyId),
+		Time:          time.Now(),
+	})
 }
@@ -0,0 +1,40 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+package core
+
+import (
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+)
+
+var _ *tg.Bool
+
+// SessionQueryAuthKeyInfo
+// session.queryAuthKeyInfo auth_key_id:long = SessionAuthKeyInfo;
+func (c *SessionCore) SessionQueryAuthKeyInfo(in *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error) {
+	keyInfo, expiresAt, err := c.svcCtx.LookupAuthKey(c.ctx, in.AuthKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return session.MakeSessionAuthKeyInfo(&session.TLSessionAuthKeyInfo{
+		ClazzID:   session.ClazzID_sessionAuthKeyInfo,
+		AuthKey:   fromAuthKeyInfo2(keyInfo),
+		ExpiresAt: expiresAt,
+	}), nil
+}
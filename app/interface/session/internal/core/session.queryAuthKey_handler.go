@@ -14,7 +14,6 @@
 // limitations under the License.
 //
 // Author: teamgramio (teamgram.io@gmail.com)
-//
 
 package core
 
@@ -28,15 +27,10 @@ var _ *tg.Bool
 // SessionQueryAuthKey
 // session.queryAuthKey auth_key_id:long = AuthKeyInfo;
 func (c *SessionCore) SessionQueryAuthKey(in *session.TLSessionQueryAuthKey) (*tg.AuthKeyInfo, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.queryAuthKey blocked, License key from https://teamgram.net required to unlock enterprise features.")
-	return tg.MakeAuthKeyInfo(&tg.TLAuthKeyInfo{
-		ClazzID:            tg.ClazzID_authKeyInfo,
-		AuthKeyId:          1234567890,
-		AuthKey:            []byte("01234567890"),
-		AuthKeyType:        1,
-		PermAuthKeyId:      1234567890,
-		TempAuthKeyId:      12345678900,
-		MediaTempAuthKeyId: 1234567890,
-	}), nil
+	keyInfo, _, err := c.svcCtx.LookupAuthKey(c.ctx, in.AuthKeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return fromAuthKeyInfo2(keyInfo), nil
 }
@@ -19,10 +19,18 @@
 package core
 
 import (
-	"errors"
+	"context"
+	"time"
 
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
 	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/dao"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
 )
 
 var _ *tg.Bool
@@ -30,8 +38,89 @@ var _ *tg.Bool
 // SessionSendDataToSession
 // session.sendDataToSession data:SessionClientData = Bool;
 func (c *SessionCore) SessionSendDataToSession(in *session.TLSessionSendDataToSession) (*tg.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.sendDataToSession blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.Data == nil {
+		return tg.BoolFalse, nil
+	}
+	data, ok := in.Data.ToSessionClientData()
+	if !ok {
+		return tg.BoolFalse, nil
+	}
+
+	forwarded := c.forward("session.sendDataToSession", data.AuthKeyId, data.PermAuthKeyId, func(ctx context.Context, cli sessionclient.SessionClient) error {
+		_, err := cli.SessionSendDataToSession(ctx, &session2.TLSessionSendDataToSession{
+			Data: toSessionClientData2(data),
+		})
+		return err
+	})
+	if forwarded {
+		return tg.BoolTrue, nil
+	}
+
+	if c.svcCtx.ObserveSessionData(data.PermAuthKeyId, data.AuthKeyId, data.SessionId, data.ServerId, data.Salt, data.Payload) {
+		logx.WithContext(c.ctx).Infof("session.sendDataToSession - destroy_auth_key: %d", data.AuthKeyId)
+		c.invalidateAuthKey(data.AuthKeyId, true)
+		return tg.BoolTrue, nil
+	}
+
+	requests, err := dao.UnpackClientRequests(data.Payload)
+	if err != nil {
+		logx.WithContext(c.ctx).Errorf("session.sendDataToSession - auth_key_id(%d) session_id(%d) unpack error: %v", data.AuthKeyId, data.SessionId, err)
+		return nil, err
+	}
+
+	// the session knows the layer of the client, a request doesn't repeat its invokeWithLayer
+	live, _ := c.svcCtx.GetLiveSession(data.PermAuthKeyId, data.AuthKeyId, data.SessionId)
+	for _, req := range requests {
+		c.dispatch(data, live.Layer, req)
+	}
+
+	return tg.BoolTrue, nil
+}
+
+// dispatch answers the ping of the client, drops the service messages the gnetway or the
+// session handled already, and invokes the rpcs, their results come back to
+// SessionPushRpcResultData. The rpcs of a container run concurrently, but for those
+// of an invokeAfterMsg(s).
+func (c *SessionCore) dispatch(data *session.TLSessionClientData, layer int32, req *dao.ClientRequest) {
+	switch r := req.Object.(type) {
+	case *mtproto.TLPing:
+		x := mtproto.NewEncodeBuf(32)
+		_ = mtproto.MakeTLPong(&mtproto.Pong{
+			MsgId:  req.MsgId,
+			PingId: r.PingId,
+		}).Encode(x, layer)
+		if _, err := c.svcCtx.SendToSession(c.ctx, data.PermAuthKeyId, data.AuthKeyId, data.SessionId, x.GetBuf(), false); err != nil {
+			logx.WithContext(c.ctx).Errorf("session.sendDataToSession - auth_key_id(%d) session_id(%d) pong error: %v", data.AuthKeyId, data.SessionId, err)
+		}
+		return
+	case *mtproto.TLPingDelayDisconnect,
+		*mtproto.TLMsgsAck,
+		*mtproto.TLHttpWait,
+		*mtproto.TLDestroyAuthKey:
+		// the gnetway answered the ping_delay_disconnect, ObserveSessionData saw the others
+		return
+	case *mtproto.TLMsgsStateReq,
+		*mtproto.TLMsgResendReq,
+		*mtproto.TLMsgsAllInfo,
+		*mtproto.TLGetFutureSalts,
+		*mtproto.TLDestroySession,
+		*mtproto.TLRpcDropAnswer:
+		logx.WithContext(c.ctx).Debugf("session.sendDataToSession - auth_key_id(%d) session_id(%d) ignore %s", data.AuthKeyId, data.SessionId, r)
+		return
+	}
 
-	return nil, errors.New("session.sendDataToSession not implemented")
+	if req.Layer != 0 {
+		layer = req.Layer
+	}
+	c.svcCtx.Invoke(&metadata.RpcMetadata{
+		ServerId:      data.ServerId,
+		ClientAddr:    data.ClientIp,
+		AuthId:        data.AuthKeyId,
+		SessionId:     data.SessionId,
+		ReceiveTime:   time.Now().UnixMilli(),
+		ClientMsgId:   req.MsgId,
+		Layer:         layer,
+		Langpack:      req.LangPack,
+		PermAuthKeyId: data.PermAuthKeyId,
+	}, req.Object, req.AfterMsgIds...)
 }
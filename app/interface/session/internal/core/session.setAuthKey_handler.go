@@ -14,15 +14,18 @@
 // limitations under the License.
 //
 // Author: teamgramio (teamgram.io@gmail.com)
-//
 
 package core
 
 import (
-	"errors"
+	"context"
+	"time"
 
+	"github.com/teamgram/proto/mtproto"
 	"github.com/teamgram/proto/v2/tg"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
 )
 
 var _ *tg.Bool
@@ -30,8 +33,37 @@ var _ *tg.Bool
 // SessionSetAuthKey
 // session.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
 func (c *SessionCore) SessionSetAuthKey(in *session.TLSessionSetAuthKey) (*tg.Bool, error) {
-	// TODO: not impl
-	// c.Logger.Errorf("session.setAuthKey blocked, License key from https://teamgram.net required to unlock enterprise features.")
+	if in.AuthKey == nil {
+		return nil, mtproto.ErrInputRequestInvalid
+	}
+	keyInfo, ok := in.AuthKey.ToAuthKeyInfo()
+	if !ok {
+		return nil, mtproto.ErrInputRequestInvalid
+	}
+
+	key2 := toAuthKeyInfo2(keyInfo)
+	forwarded := c.forward("session.setAuthKey", keyInfo.AuthKeyId, keyInfo.PermAuthKeyId, func(ctx context.Context, cli sessionclient.SessionClient) error {
+		_, err := cli.SessionSetAuthKey(ctx, &session2.TLSessionSetAuthKey{
+			AuthKey:   key2,
+			ExpiresIn: in.ExpiresIn,
+		})
+		return err
+	})
+	if forwarded {
+		return tg.BoolTrue, nil
+	}
+
+	c.svcCtx.PutAuthKey(key2, authKeyExpiresAt(keyInfo.AuthKeyType, in.ExpiresIn))
+
+	return tg.BoolTrue, nil
+}
+
+// authKeyExpiresAt converts the expires_in of a temp or media-temp key to an absolute time,
+// 0 for a key that doesn't expire.
+func authKeyExpiresAt(keyType int32, expiresIn int32) int64 {
+	if keyType == mtproto.AuthKeyTypePerm || expiresIn <= 0 {
+		return 0
+	}
 
-	return nil, errors.New("session.setAuthKey not implemented")
+	return time.Now().Unix() + int64(expiresIn)
 }
@@ -0,0 +1,337 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type authKeyBinding struct {
+	permAuthKeyId int64
+	expiresAt     int64
+}
+
+type authKeyEntry struct {
+	keyInfo   *mtproto.AuthKeyInfo
+	expiresAt int64
+}
+
+func (v authKeyEntry) expired(now int64) bool {
+	return v.expiresAt > 0 && now >= v.expiresAt
+}
+
+// owner is the auth key the entry is sharded by, see sessionclient.ShardKey.
+func (v authKeyEntry) owner() int64 {
+	return ownerAuthKeyId(v.keyInfo.AuthKeyId, v.keyInfo.PermAuthKeyId)
+}
+
+// AuthKeyDirectory maps temp auth keys to the perm auth key they are bound to. The entry
+// of a temp key lives on the session node owning the temp key itself, the node a gnetway
+// asks when it doesn't know the perm key yet. The node of the perm key writes it with
+// session.bindTempAuthKey once auth.bindTempAuthKey succeeded.
+//
+// It keeps the auth keys the gnetways set with session.setAuthKey too, each on the node
+// owning the key, with the time a temp key expires at. A temp key moves to the node of
+// its perm key when it is bound, next to the sessions using it.
+type AuthKeyDirectory struct {
+	shards   *SessionShards
+	mu       sync.Mutex
+	bindings map[int64]authKeyBinding
+	keys     map[int64]authKeyEntry
+}
+
+func NewAuthKeyDirectory(shards *SessionShards) *AuthKeyDirectory {
+	d := &AuthKeyDirectory{
+		shards:   shards,
+		bindings: make(map[int64]authKeyBinding),
+		keys:     make(map[int64]authKeyEntry),
+	}
+	go d.evictLoop()
+
+	return d
+}
+
+// BindTempAuthKey records on the owning node that tempAuthKeyId is bound to permAuthKeyId
+// until expiresAt.
+func (d *AuthKeyDirectory) BindTempAuthKey(ctx context.Context, permAuthKeyId, tempAuthKeyId, expiresAt int64) error {
+	cli, local := d.shards.Owner(sessionclient.ShardKey(tempAuthKeyId, 0))
+	if local {
+		return d.BindLocal(ctx, permAuthKeyId, tempAuthKeyId, expiresAt)
+	}
+
+	_, err := cli.SessionBindTempAuthKey(ctx, &session.TLSessionBindTempAuthKey{
+		PermAuthKeyId: permAuthKeyId,
+		TempAuthKeyId: tempAuthKeyId,
+		ExpiresAt:     expiresAt,
+	})
+
+	return err
+}
+
+// BindLocal stores a binding of a temp key this node owns and hands the key over to the
+// node owning permAuthKeyId. If that fails the key stays here, LookupAuthKey still finds it.
+func (d *AuthKeyDirectory) BindLocal(ctx context.Context, permAuthKeyId, tempAuthKeyId, expiresAt int64) error {
+	d.PutBinding(permAuthKeyId, tempAuthKeyId, expiresAt)
+
+	keyInfo, keyExpiresAt, ok := d.GetAuthKey(tempAuthKeyId)
+	if !ok {
+		return nil
+	}
+	keyInfo = withPermAuthKeyId(keyInfo, permAuthKeyId)
+
+	cli, local := d.shards.Owner(sessionclient.ShardKey(tempAuthKeyId, permAuthKeyId))
+	if local {
+		d.PutAuthKey(keyInfo, keyExpiresAt)
+		return nil
+	}
+
+	var expiresIn int32
+	if keyExpiresAt > 0 {
+		if expiresIn = int32(keyExpiresAt - time.Now().Unix()); expiresIn <= 0 {
+			return nil
+		}
+	}
+	_, err := cli.SessionSetAuthKey(ctx, &session.TLSessionSetAuthKey{
+		AuthKey:   keyInfo,
+		ExpiresIn: expiresIn,
+	})
+	if err != nil {
+		return err
+	}
+	d.RemoveAuthKey(tempAuthKeyId)
+
+	return nil
+}
+
+// LookupAuthKey returns a key the gnetways set, with the time it expires at. A temp key
+// comes with the perm key it is bound to. Asked for a bound temp key that moved, the node
+// owning the temp key asks the node of its perm key.
+func (d *AuthKeyDirectory) LookupAuthKey(ctx context.Context, authKeyId int64) (*mtproto.AuthKeyInfo, int64, error) {
+	permAuthKeyId, bound := d.LookupBinding(authKeyId)
+
+	if keyInfo, expiresAt, ok := d.GetAuthKey(authKeyId); ok {
+		if bound && keyInfo.PermAuthKeyId == 0 {
+			keyInfo = withPermAuthKeyId(keyInfo, permAuthKeyId)
+		}
+		return keyInfo, expiresAt, nil
+	}
+	if !bound {
+		return nil, 0, mtproto.ErrAuthKeyUnregistered
+	}
+
+	cli, local := d.shards.Owner(sessionclient.ShardKey(authKeyId, permAuthKeyId))
+	if local {
+		return nil, 0, mtproto.ErrAuthKeyUnregistered
+	}
+	r, err := cli.SessionQueryAuthKeyInfo(ctx, &session.TLSessionQueryAuthKeyInfo{
+		AuthKeyId: authKeyId,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return r.GetAuthKey(), r.GetExpiresAt(), nil
+}
+
+func withPermAuthKeyId(v *mtproto.AuthKeyInfo, permAuthKeyId int64) *mtproto.AuthKeyInfo {
+	return mtproto.MakeTLAuthKeyInfo(&mtproto.AuthKeyInfo{
+		AuthKeyId:          v.AuthKeyId,
+		AuthKey:            v.AuthKey,
+		AuthKeyType:        v.AuthKeyType,
+		PermAuthKeyId:      permAuthKeyId,
+		TempAuthKeyId:      v.TempAuthKeyId,
+		MediaTempAuthKeyId: v.MediaTempAuthKeyId,
+	}).To_AuthKeyInfo()
+}
+
+// PutBinding stores a binding of a temp key this node owns.
+func (d *AuthKeyDirectory) PutBinding(permAuthKeyId, tempAuthKeyId, expiresAt int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.bindings[tempAuthKeyId] = authKeyBinding{
+		permAuthKeyId: permAuthKeyId,
+		expiresAt:     expiresAt,
+	}
+}
+
+// LookupBinding looks a temp key this node owns up.
+func (d *AuthKeyDirectory) LookupBinding(tempAuthKeyId int64) (int64, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	v, ok := d.bindings[tempAuthKeyId]
+	if !ok {
+		return 0, false
+	}
+	if v.expiresAt > 0 && time.Now().Unix() >= v.expiresAt {
+		delete(d.bindings, tempAuthKeyId)
+		return 0, false
+	}
+
+	return v.permAuthKeyId, true
+}
+
+// RemoveBindings drops the binding of the temp key authKeyId, or all bindings to the
+// perm key authKeyId.
+func (d *AuthKeyDirectory) RemoveBindings(authKeyId int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.bindings, authKeyId)
+	for tempAuthKeyId, v := range d.bindings {
+		if v.permAuthKeyId == authKeyId {
+			delete(d.bindings, tempAuthKeyId)
+		}
+	}
+}
+
+// BoundTempAuthKeyIds returns the temp keys with a binding here.
+func (d *AuthKeyDirectory) BoundTempAuthKeyIds() []int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ids := make([]int64, 0, len(d.bindings))
+	for tempAuthKeyId := range d.bindings {
+		ids = append(ids, tempAuthKeyId)
+	}
+
+	return ids
+}
+
+// ExportBindings removes the bindings of the temp keys in owners and returns them.
+func (d *AuthKeyDirectory) ExportBindings(owners map[int64]struct{}) map[int64]authKeyBinding {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	exported := make(map[int64]authKeyBinding)
+	for tempAuthKeyId := range owners {
+		if v, ok := d.bindings[tempAuthKeyId]; ok {
+			exported[tempAuthKeyId] = v
+			delete(d.bindings, tempAuthKeyId)
+		}
+	}
+
+	return exported
+}
+
+// PutAuthKey stores a key this node owns, expiresAt is 0 for a perm key.
+func (d *AuthKeyDirectory) PutAuthKey(keyInfo *mtproto.AuthKeyInfo, expiresAt int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.keys[keyInfo.AuthKeyId] = authKeyEntry{
+		keyInfo:   keyInfo,
+		expiresAt: expiresAt,
+	}
+}
+
+// GetAuthKey looks a key this node owns up, with the time it expires at.
+func (d *AuthKeyDirectory) GetAuthKey(authKeyId int64) (*mtproto.AuthKeyInfo, int64, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	v, ok := d.keys[authKeyId]
+	if !ok {
+		return nil, 0, false
+	}
+	if v.expired(time.Now().Unix()) {
+		delete(d.keys, authKeyId)
+		return nil, 0, false
+	}
+
+	return v.keyInfo, v.expiresAt, true
+}
+
+// RemoveAuthKey drops the key authKeyId.
+func (d *AuthKeyDirectory) RemoveAuthKey(authKeyId int64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.keys, authKeyId)
+}
+
+// AuthKeyIds returns the owners of the keys stored here.
+func (d *AuthKeyDirectory) AuthKeyIds() []int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	ids := make([]int64, 0, len(d.keys))
+	for _, v := range d.keys {
+		ids = append(ids, v.owner())
+	}
+
+	return ids
+}
+
+// ExportAuthKeys removes the keys owned by owners and returns them by owner.
+func (d *AuthKeyDirectory) ExportAuthKeys(owners map[int64]struct{}) map[int64][]authKeyEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	exported := make(map[int64][]authKeyEntry)
+	for authKeyId, v := range d.keys {
+		owner := v.owner()
+		if _, ok := owners[owner]; ok {
+			exported[owner] = append(exported[owner], v)
+			delete(d.keys, authKeyId)
+		}
+	}
+
+	return exported
+}
+
+func (d *AuthKeyDirectory) evictLoop() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		var (
+			now     = time.Now().Unix()
+			evicted int
+		)
+
+		d.mu.Lock()
+		for tempAuthKeyId, v := range d.bindings {
+			if v.expiresAt > 0 && now >= v.expiresAt {
+				delete(d.bindings, tempAuthKeyId)
+				evicted++
+			}
+		}
+		for authKeyId, v := range d.keys {
+			if v.expired(now) {
+				delete(d.keys, authKeyId)
+				evicted++
+			}
+		}
+		d.mu.Unlock()
+
+		if evicted > 0 {
+			logx.Statf("auth_key_directory - evicted: %d", evicted)
+		}
+	}
+}
@@ -18,9 +18,59 @@
 
 package dao
 
+import (
+	"time"
+
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+)
+
 type Dao struct {
+	*GatewayClients
+	*SessionShards
+	*AuthKeyDirectory
+	*Handoff
+	*Invoker
+	*LiveSessions
+	*PushSinks
+	*Help
+
+	deliveryTimeout time.Duration
+}
+
+func New(c config.Config, serverId string) *Dao {
+	peers := c.Peers
+	if len(peers.Endpoints) == 0 && len(peers.Target) == 0 && len(peers.Etcd.Hosts) == 0 {
+		peers.Etcd = c.Etcd
+	}
+
+	var (
+		shards    = NewSessionShards(peers, c.PeerWeights, serverId)
+		gateways  = NewGatewayClients()
+		directory = NewAuthKeyDirectory(shards)
+	)
+
+	return &Dao{
+		GatewayClients:   gateways,
+		SessionShards:    shards,
+		AuthKeyDirectory: directory,
+		Handoff:          NewHandoff(c.Handoff, serverId, shards, gateways, directory),
+		Invoker:          NewInvoker(c.Upstream, c.Queue),
+		LiveSessions:     NewLiveSessions(),
+		PushSinks:        NewPushSinks(c.Push),
+		Help:             NewHelp(c.Help),
+		deliveryTimeout:  c.Push.DeliveryTimeout,
+	}
 }
 
-func New() *Dao {
-	return new(Dao)
+// RebindSessions files the sessions and the gnetways of the temp key tempAuthKeyId, opened
+// before auth.bindTempAuthKey, under the perm key permAuthKeyId. They are handed off if
+// another session node owns the perm key.
+func (d *Dao) RebindSessions(tempAuthKeyId, permAuthKeyId int64) {
+	d.BindAuthKeyGateways(permAuthKeyId, tempAuthKeyId)
+	d.RebindLiveSessions(tempAuthKeyId, permAuthKeyId)
+
+	if _, local := d.OwnerAddr(sessionclient.ShardKey(tempAuthKeyId, permAuthKeyId)); !local {
+		go d.rebalance()
+	}
 }
@@ -0,0 +1,371 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	gateway_client "github.com/teamgram/teamgram-server/v2/app/interface/gnetway/client"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type authKeyGateways struct {
+	permAuthKeyId int64
+	// server_id -> sessions on that gateway
+	servers map[string]int
+}
+
+// GatewayClients keeps a client per gnetway and which gnetway hosts every auth key.
+// A gnetway reports its grpc listen address as server_id, the client dials it directly,
+// unless the gnetway keeps a stream open to us.
+type GatewayClients struct {
+	mu       sync.Mutex
+	clients  map[string]gateway_client.GatewayClient
+	links    map[string]*streamlink.Link
+	authKeys map[int64]*authKeyGateways
+	// perm_auth_key_id -> temp auth_key_ids bound to it
+	bindings map[int64]map[int64]struct{}
+}
+
+func NewGatewayClients() *GatewayClients {
+	return &GatewayClients{
+		clients:  make(map[string]gateway_client.GatewayClient),
+		links:    make(map[string]*streamlink.Link),
+		authKeys: make(map[int64]*authKeyGateways),
+		bindings: make(map[int64]map[int64]struct{}),
+	}
+}
+
+func (m *GatewayClients) getGatewayClient(serverId string) (gateway_client.GatewayClient, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cli, err := m.getRpcClientLocked(serverId)
+	if err != nil {
+		return nil, err
+	}
+	if link, ok := m.links[serverId]; ok && link.Connected() {
+		return gateway_client.NewGatewayStreamClient(link, cli), nil
+	}
+
+	return cli, nil
+}
+
+func (m *GatewayClients) getRpcClientLocked(serverId string) (gateway_client.GatewayClient, error) {
+	if cli, ok := m.clients[serverId]; ok {
+		return cli, nil
+	}
+
+	cli, err := zrpc.NewClient(zrpc.RpcClientConf{
+		Endpoints: []string{serverId},
+		NonBlock:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	m.clients[serverId] = gateway_client.NewGatewayClient(cli)
+
+	return m.clients[serverId], nil
+}
+
+// SetGatewayClient makes the calls to the gnetway serverId go to cli, e.g. to a gnetway
+// hosted in the same process.
+func (m *GatewayClients) SetGatewayClient(serverId string, cli gateway_client.GatewayClient) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.clients[serverId] = cli
+}
+
+// GetOrCreateLink returns the stream link of the gnetway serverId, it outlives the
+// streams the gnetway opens so that unacked frames survive a reconnect.
+func (m *GatewayClients) GetOrCreateLink(serverId string, c streamlink.Config, handler streamlink.Handler) *streamlink.Link {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	link, ok := m.links[serverId]
+	if !ok {
+		link = streamlink.New(c, serverId, handler)
+		m.links[serverId] = link
+	}
+
+	return link
+}
+
+// AddAuthKeyGateway records a session of authKeyId on the gnetway serverId.
+func (m *GatewayClients) AddAuthKeyGateway(permAuthKeyId, authKeyId int64, serverId string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.authKeys[authKeyId]
+	if !ok {
+		v = &authKeyGateways{
+			permAuthKeyId: permAuthKeyId,
+			servers:       make(map[string]int),
+		}
+		m.authKeys[authKeyId] = v
+	}
+	v.servers[serverId]++
+
+	m.bindLocked(v, permAuthKeyId, authKeyId)
+}
+
+// BindAuthKeyGateways files the gnetways of the temp key authKeyId, recorded before it was
+// bound, under the perm key permAuthKeyId.
+func (m *GatewayClients) BindAuthKeyGateways(permAuthKeyId, authKeyId int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if v, ok := m.authKeys[authKeyId]; ok {
+		m.bindLocked(v, permAuthKeyId, authKeyId)
+	}
+}
+
+func (m *GatewayClients) bindLocked(v *authKeyGateways, permAuthKeyId, authKeyId int64) {
+	if permAuthKeyId == 0 || permAuthKeyId == authKeyId {
+		return
+	}
+
+	v.permAuthKeyId = permAuthKeyId
+	if _, ok := m.bindings[permAuthKeyId]; !ok {
+		m.bindings[permAuthKeyId] = make(map[int64]struct{})
+	}
+	m.bindings[permAuthKeyId][authKeyId] = struct{}{}
+}
+
+// RemoveAuthKeyGateway drops a session of authKeyId on the gnetway serverId.
+func (m *GatewayClients) RemoveAuthKeyGateway(authKeyId int64, serverId string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.authKeys[authKeyId]
+	if !ok {
+		return
+	}
+
+	if v.servers[serverId]--; v.servers[serverId] <= 0 {
+		delete(v.servers, serverId)
+	}
+	if len(v.servers) == 0 {
+		m.removeAuthKeyLocked(authKeyId)
+	}
+}
+
+// OwnedAuthKeyIds returns the owning auth key of every key with a gnetway here.
+func (m *GatewayClients) OwnedAuthKeyIds() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var (
+		seen = make(map[int64]struct{}, len(m.authKeys))
+		ids  = make([]int64, 0, len(m.authKeys))
+	)
+	for authKeyId, v := range m.authKeys {
+		ownerId := ownerAuthKeyId(authKeyId, v.permAuthKeyId)
+		if _, ok := seen[ownerId]; !ok {
+			seen[ownerId] = struct{}{}
+			ids = append(ids, ownerId)
+		}
+	}
+
+	return ids
+}
+
+// ExportAuthKeyGateways removes the keys whose owning auth key is in owners and
+// returns their gnetways by owning auth key.
+func (m *GatewayClients) ExportAuthKeyGateways(owners map[int64]struct{}) map[int64][]*session.SessionAuthKeyGateway {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	exported := make(map[int64][]*session.SessionAuthKeyGateway)
+	for authKeyId, v := range m.authKeys {
+		ownerId := ownerAuthKeyId(authKeyId, v.permAuthKeyId)
+		if _, ok := owners[ownerId]; !ok {
+			continue
+		}
+		for serverId, n := range v.servers {
+			exported[ownerId] = append(exported[ownerId], &session.SessionAuthKeyGateway{
+				AuthKeyId:     authKeyId,
+				PermAuthKeyId: v.permAuthKeyId,
+				ServerId:      serverId,
+				Sessions:      int32(n),
+			})
+		}
+		m.removeAuthKeyLocked(authKeyId)
+	}
+
+	return exported
+}
+
+// ImportAuthKeyGateways adds the gnetways another session node handed off to us.
+func (m *GatewayClients) ImportAuthKeyGateways(gateways []*session.SessionAuthKeyGateway) {
+	for _, g := range gateways {
+		for i := int32(0); i < g.Sessions; i++ {
+			m.AddAuthKeyGateway(g.PermAuthKeyId, g.AuthKeyId, g.ServerId)
+		}
+	}
+}
+
+func (m *GatewayClients) removeAuthKeyLocked(authKeyId int64) {
+	v, ok := m.authKeys[authKeyId]
+	if !ok {
+		return
+	}
+
+	delete(m.authKeys, authKeyId)
+	if b, ok2 := m.bindings[v.permAuthKeyId]; ok2 {
+		delete(b, authKeyId)
+		if len(b) == 0 {
+			delete(m.bindings, v.permAuthKeyId)
+		}
+	}
+}
+
+// SendDataToGateway hands the payload of a session to the gnetway serverId, it encrypts
+// and writes it, false if the gnetway has no connection of the session.
+func (m *GatewayClients) SendDataToGateway(ctx context.Context, serverId string, authKeyId, sessionId int64, payload []byte) (bool, error) {
+	cli, err := m.getGatewayClient(serverId)
+	if err != nil {
+		return false, err
+	}
+
+	r, err := cli.GatewaySendDataToGateway(ctx, &gateway.TLGatewaySendDataToGateway{
+		AuthKeyId: authKeyId,
+		SessionId: sessionId,
+		Payload:   payload,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return mtproto.FromBool(r), nil
+}
+
+// SendDataToGatewayWithReceipt is SendDataToGateway waiting up to timeout for the writes,
+// it returns what became of the payload on every connection the gnetway tried.
+func (m *GatewayClients) SendDataToGatewayWithReceipt(ctx context.Context, serverId string, authKeyId, sessionId int64, payload []byte, timeout time.Duration) ([]*gateway.DeliveryReceipt, error) {
+	cli, err := m.getGatewayClient(serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := cli.GatewaySendDataToGatewayWithReceipt(ctx, &gateway.TLGatewaySendDataToGatewayWithReceipt{
+		AuthKeyId: authKeyId,
+		SessionId: sessionId,
+		Payload:   payload,
+		Timeout:   int32(timeout / time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetDatas(), nil
+}
+
+// SendBatchDataToGateway hands many payloads to the gnetway serverId in one call and
+// waits up to timeout for the writes, the result of items[i] is at i.
+func (m *GatewayClients) SendBatchDataToGateway(ctx context.Context, serverId string, items []*gateway.GatewayData, timeout time.Duration) ([]*gateway.GatewayDeliveryResult, error) {
+	cli, err := m.getGatewayClient(serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := cli.GatewaySendBatchDataToGateway(ctx, &gateway.TLGatewaySendBatchDataToGateway{
+		Items:   items,
+		Timeout: int32(timeout / time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetDatas(), nil
+}
+
+// BroadcastDataToGateway has the gnetway serverId frame body for every session of
+// permAuthKeyId it hosts and write it, it returns the result per session. The gnetway
+// frames it with seqno 0, body must not be content related, the session frames the
+// others itself, see SendBatchDataToGateway.
+func (m *GatewayClients) BroadcastDataToGateway(ctx context.Context, serverId string, permAuthKeyId int64, body []byte, timeout time.Duration) ([]*gateway.GatewayDeliveryResult, error) {
+	cli, err := m.getGatewayClient(serverId)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := cli.GatewayBroadcastDataToGateway(ctx, &gateway.TLGatewayBroadcastDataToGateway{
+		PermAuthKeyId: permAuthKeyId,
+		Body:          body,
+		Timeout:       int32(timeout / time.Millisecond),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.GetDatas(), nil
+}
+
+// InvalidateAuthKey tells every gnetway that hosted authKeyId, or a temp key bound to it,
+// to drop the key from its cache and to close the connections using it.
+func (m *GatewayClients) InvalidateAuthKey(ctx context.Context, authKeyId int64, destroyed bool) {
+	servers := make(map[string]struct{})
+
+	m.mu.Lock()
+	keyIdList := []int64{authKeyId}
+	for kId := range m.bindings[authKeyId] {
+		keyIdList = append(keyIdList, kId)
+	}
+	for _, kId := range keyIdList {
+		if v, ok := m.authKeys[kId]; ok {
+			for serverId := range v.servers {
+				servers[serverId] = struct{}{}
+			}
+		}
+		m.removeAuthKeyLocked(kId)
+	}
+	m.mu.Unlock()
+
+	group := threading.NewRoutineGroup()
+	for serverId := range servers {
+		serverId := serverId
+		group.RunSafe(func() {
+			cli, err := m.getGatewayClient(serverId)
+			if err != nil {
+				logx.WithContext(ctx).Errorf("invalidateAuthKey - dial gateway(%s) error: %v", serverId, err)
+				return
+			}
+
+			_, err = cli.GatewayInvalidateAuthKey(ctx, &gateway.TLGatewayInvalidateAuthKey{
+				AuthKeyId: authKeyId,
+				Destroyed: destroyed,
+			})
+			if err != nil {
+				logx.WithContext(ctx).Errorf("invalidateAuthKey - gateway(%s) auth_key_id(%d) error: %v", serverId, authKeyId, err)
+			}
+		})
+	}
+	group.Wait()
+}
@@ -0,0 +1,353 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+var (
+	ErrPeerNotFound = errors.New("handoff: session node not dialed")
+)
+
+// SessionStateProvider is implemented by the session core to move its in-memory state of
+// an owning auth key, queues, salts and pending rpcs, to another session node.
+type SessionStateProvider interface {
+	SessionStateKeys() []int64
+	// ExportSessionState removes the state of authKeyId and returns it.
+	ExportSessionState(authKeyId int64) ([]byte, error)
+	ImportSessionState(authKeyId int64, state []byte) error
+}
+
+type forward struct {
+	to    string
+	ready bool
+	queue []func(cli sessionclient.SessionClient)
+}
+
+// Handoff moves the state of the auth keys we don't own anymore to their new session node,
+// after the ring changed or when we drain. The calls about a key reaching us meanwhile are
+// queued until its state arrived there, then forwarded.
+type Handoff struct {
+	c         config.HandoffConfig
+	serverId  string
+	shards    *SessionShards
+	gateways  *GatewayClients
+	directory *AuthKeyDirectory
+	provider  SessionStateProvider
+
+	runMu    sync.Mutex // one handoff at a time
+	mu       sync.Mutex
+	forwards map[int64]*forward
+}
+
+func NewHandoff(c config.HandoffConfig, serverId string, shards *SessionShards, gateways *GatewayClients, directory *AuthKeyDirectory) *Handoff {
+	if c.BatchSize <= 0 {
+		c.BatchSize = 128
+	}
+	if c.ForwardFor <= 0 {
+		c.ForwardFor = 2 * time.Minute
+	}
+
+	h := &Handoff{
+		c:         c,
+		serverId:  serverId,
+		shards:    shards,
+		gateways:  gateways,
+		directory: directory,
+		forwards:  make(map[int64]*forward),
+	}
+	shards.SetChangeHook(func() {
+		go h.rebalance()
+	})
+
+	return h
+}
+
+// SetSessionStateProvider is called by the session core before serving.
+func (h *Handoff) SetSessionStateProvider(provider SessionStateProvider) {
+	h.provider = provider
+}
+
+func ownerAuthKeyId(authKeyId, permAuthKeyId int64) int64 {
+	if permAuthKeyId != 0 {
+		return permAuthKeyId
+	}
+
+	return authKeyId
+}
+
+// Forward queues or forwards call if the auth key moved to another session node,
+// it returns false if the key is still ours.
+func (h *Handoff) Forward(authKeyId, permAuthKeyId int64, call func(cli sessionclient.SessionClient)) bool {
+	ownerId := ownerAuthKeyId(authKeyId, permAuthKeyId)
+
+	h.mu.Lock()
+	f, ok := h.forwards[ownerId]
+	if !ok {
+		h.mu.Unlock()
+		return false
+	}
+	if !f.ready {
+		f.queue = append(f.queue, call)
+		h.mu.Unlock()
+		return true
+	}
+	to := f.to
+	h.mu.Unlock()
+
+	if _, cli, ok2 := h.shards.Peer(to); ok2 {
+		call(cli)
+	} else {
+		logx.Errorf("handoff - forward auth_key_id(%d) to session(%s): %v", ownerId, to, ErrPeerNotFound)
+	}
+
+	return true
+}
+
+// ownedAuthKeyIds returns every owning auth key we keep something of.
+func (h *Handoff) ownedAuthKeyIds() []int64 {
+	ids := h.gateways.OwnedAuthKeyIds()
+	ids = append(ids, h.directory.BoundTempAuthKeyIds()...)
+	ids = append(ids, h.directory.AuthKeyIds()...)
+	if h.provider != nil {
+		ids = append(ids, h.provider.SessionStateKeys()...)
+	}
+
+	return ids
+}
+
+// rebalance hands off the keys another session node owns since the last ring change.
+func (h *Handoff) rebalance() {
+	h.handOffAll(context.Background(), func(key string) (string, bool) {
+		addr, local := h.shards.OwnerAddr(key)
+		return addr, !local
+	})
+}
+
+// Drain hands off every key to the node owning it once we left the ring, it is called
+// on shutdown, before the gnetways notice we are gone.
+func (h *Handoff) Drain(ctx context.Context) error {
+	return h.handOffAll(ctx, h.shards.SuccessorFunc())
+}
+
+func (h *Handoff) handOffAll(ctx context.Context, ownerOf func(key string) (string, bool)) error {
+	h.runMu.Lock()
+	defer h.runMu.Unlock()
+
+	groups := make(map[string]map[int64]struct{})
+
+	h.mu.Lock()
+	for _, id := range h.ownedAuthKeyIds() {
+		if _, ok := h.forwards[id]; ok {
+			continue
+		}
+		to, ok := ownerOf(sessionclient.ShardKey(id, 0))
+		if !ok || to == h.serverId {
+			continue
+		}
+		if _, _, ok = h.shards.Peer(to); !ok {
+			// not dialed, keep it until the next change
+			continue
+		}
+		if _, ok = groups[to]; !ok {
+			groups[to] = make(map[int64]struct{})
+		}
+		groups[to][id] = struct{}{}
+		h.forwards[id] = &forward{to: to}
+	}
+	h.mu.Unlock()
+
+	var (
+		errMu    sync.Mutex
+		firstErr error
+		group    = threading.NewRoutineGroup()
+	)
+	for to, owners := range groups {
+		to, owners := to, owners
+		group.RunSafe(func() {
+			if err := h.handOff(ctx, to, owners); err != nil {
+				logx.Errorf("handoff - %d auth keys to session(%s) error: %v", len(owners), to, err)
+				errMu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errMu.Unlock()
+			}
+		})
+	}
+	group.Wait()
+
+	return firstErr
+}
+
+// handOff streams the state of owners to the session node to, then forwards what was
+// queued meanwhile. The keys belong to the new node even if it fails, so we forward anyway.
+func (h *Handoff) handOff(ctx context.Context, to string, owners map[int64]struct{}) (err error) {
+	defer h.release(owners)
+
+	conn, _, ok := h.shards.Peer(to)
+	if !ok {
+		return ErrPeerNotFound
+	}
+
+	states := h.export(owners)
+	stream, err := session.NewRPCSessionHandoffClient(conn.Conn()).SessionHandoff(ctx)
+	if err != nil {
+		return err
+	}
+
+	for len(states) > 0 {
+		n := h.c.BatchSize
+		if n > len(states) {
+			n = len(states)
+		}
+		err = stream.Send(&session.SessionHandoffChunk{
+			FromServerId: h.serverId,
+			States:       states[:n],
+		})
+		if err != nil {
+			return err
+		}
+		states = states[n:]
+	}
+
+	r, err := stream.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	logx.Infof("handoff - %d auth keys to session(%s), imported: %d", len(owners), to, r.GetImported())
+
+	return nil
+}
+
+func (h *Handoff) export(owners map[int64]struct{}) []*session.SessionAuthKeyState {
+	var (
+		gateways = h.gateways.ExportAuthKeyGateways(owners)
+		bindings = h.directory.ExportBindings(owners)
+		keys     = h.directory.ExportAuthKeys(owners)
+		states   = make([]*session.SessionAuthKeyState, 0, len(owners))
+	)
+
+	for id := range owners {
+		st := &session.SessionAuthKeyState{
+			AuthKeyId: id,
+			Gateways:  gateways[id],
+		}
+		if v, ok := bindings[id]; ok {
+			st.BoundPermAuthKeyId = v.permAuthKeyId
+			st.BoundExpiresAt = v.expiresAt
+		}
+		for _, v := range keys[id] {
+			st.AuthKeys = append(st.AuthKeys, &session.SessionHandoffAuthKey{
+				AuthKey:   v.keyInfo,
+				ExpiresAt: v.expiresAt,
+			})
+		}
+		if h.provider != nil {
+			sessions, err := h.provider.ExportSessionState(id)
+			if err != nil {
+				logx.Errorf("handoff - export auth_key_id(%d) error: %v", id, err)
+			}
+			st.Sessions = sessions
+		}
+		states = append(states, st)
+	}
+
+	return states
+}
+
+// release forwards the calls queued for owners and forwards the next ones right away,
+// for ForwardFor, by then the gnetways know the new owner.
+func (h *Handoff) release(owners map[int64]struct{}) {
+	for id := range owners {
+		for {
+			h.mu.Lock()
+			f, ok := h.forwards[id]
+			if !ok {
+				// the key came back to us meanwhile
+				h.mu.Unlock()
+				break
+			}
+			queue := f.queue
+			f.queue = nil
+			if len(queue) == 0 {
+				f.ready = true
+			}
+			h.mu.Unlock()
+
+			if len(queue) == 0 {
+				break
+			}
+			_, cli, ok2 := h.shards.Peer(f.to)
+			for _, call := range queue {
+				if ok2 {
+					call(cli)
+				}
+			}
+		}
+	}
+
+	time.AfterFunc(h.c.ForwardFor, func() {
+		h.mu.Lock()
+		for id := range owners {
+			delete(h.forwards, id)
+		}
+		h.mu.Unlock()
+	})
+}
+
+// Import takes over the state another session node handed off to us.
+func (h *Handoff) Import(chunk *session.SessionHandoffChunk) (imported int) {
+	for _, st := range chunk.GetStates() {
+		h.mu.Lock()
+		// the key came back to us
+		delete(h.forwards, st.AuthKeyId)
+		h.mu.Unlock()
+
+		h.gateways.ImportAuthKeyGateways(st.Gateways)
+		if st.BoundPermAuthKeyId != 0 {
+			h.directory.PutBinding(st.BoundPermAuthKeyId, st.AuthKeyId, st.BoundExpiresAt)
+		}
+		for _, v := range st.AuthKeys {
+			if v.AuthKey != nil {
+				h.directory.PutAuthKey(v.AuthKey, v.ExpiresAt)
+			}
+		}
+		if h.provider != nil && len(st.Sessions) > 0 {
+			if err := h.provider.ImportSessionState(st.AuthKeyId, st.Sessions); err != nil {
+				logx.Errorf("handoff - import auth_key_id(%d) from session(%s) error: %v", st.AuthKeyId, chunk.FromServerId, err)
+				continue
+			}
+		}
+		imported++
+	}
+
+	return
+}
@@ -0,0 +1,114 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/pkg/dcoption"
+)
+
+// Help answers help.getConfig and help.getNearestDc from the Help config, it does
+// only with Help.Listeners set.
+type Help struct {
+	help config.HelpConfig
+	// dcOptions are the dc_options of help.getConfig
+	dcOptions []*mtproto.DcOption
+}
+
+func NewHelp(c config.HelpConfig) *Help {
+	// c is validated already
+	dcOptions, _ := dcoption.DcOptions(c.Listeners)
+
+	return &Help{
+		help:      c,
+		dcOptions: dcOptions,
+	}
+}
+
+// HelpEnabled reports whether the session answers the help methods, they go upstream otherwise.
+func (h *Help) HelpEnabled() bool {
+	return h.help.Enabled()
+}
+
+// HelpConfig is the config of help.getConfig at now.
+func (h *Help) HelpConfig(now time.Time) *mtproto.Config {
+	var (
+		help   = h.help
+		limits = help.Limits
+	)
+
+	return mtproto.MakeTLConfig(&mtproto.Config{
+		DefaultP2PContacts:      help.Features.DefaultP2PContacts,
+		PreloadFeaturedStickers: help.Features.PreloadFeaturedStickers,
+		RevokePmInbox:           help.Features.RevokePmInbox,
+		BlockedMode:             help.Features.BlockedMode,
+		ForceTryIpv6:            help.Features.ForceTryIpv6,
+		Date:                    int32(now.Unix()),
+		Expires:                 int32(now.Add(help.Expires).Unix()),
+		TestMode:                mtproto.BoolFalse,
+		ThisDc:                  help.ThisDc,
+		DcOptions:               h.dcOptions,
+		DcTxtDomainName:         help.Features.DcTxtDomainName,
+		ChatSizeMax:             limits.ChatSizeMax,
+		MegagroupSizeMax:        limits.MegagroupSizeMax,
+		ForwardedCountMax:       limits.ForwardedCountMax,
+		OnlineUpdatePeriodMs:    limits.OnlineUpdatePeriodMs,
+		OfflineBlurTimeoutMs:    limits.OfflineBlurTimeoutMs,
+		OfflineIdleTimeoutMs:    limits.OfflineIdleTimeoutMs,
+		OnlineCloudTimeoutMs:    limits.OnlineCloudTimeoutMs,
+		NotifyCloudDelayMs:      limits.NotifyCloudDelayMs,
+		NotifyDefaultDelayMs:    limits.NotifyDefaultDelayMs,
+		PushChatPeriodMs:        limits.PushChatPeriodMs,
+		PushChatLimit:           limits.PushChatLimit,
+		EditTimeLimit:           limits.EditTimeLimit,
+		RevokeTimeLimit:         limits.RevokeTimeLimit,
+		RevokePmTimeLimit:       limits.RevokePmTimeLimit,
+		RatingEDecay:            limits.RatingEDecay,
+		StickersRecentLimit:     limits.StickersRecentLimit,
+		ChannelsReadMediaPeriod: limits.ChannelsReadMediaPeriod,
+		CallReceiveTimeoutMs:    limits.CallReceiveTimeoutMs,
+		CallRingTimeoutMs:       limits.CallRingTimeoutMs,
+		CallConnectTimeoutMs:    limits.CallConnectTimeoutMs,
+		CallPacketTimeoutMs:     limits.CallPacketTimeoutMs,
+		MeUrlPrefix:             help.Features.MeUrlPrefix,
+		CaptionLengthMax:        limits.CaptionLengthMax,
+		MessageLengthMax:        limits.MessageLengthMax,
+		WebfileDcId:             help.ThisDc,
+		PhonecallsEnabled:       help.Features.PhonecallsEnabled,
+		IgnorePhoneEntities:     help.Features.IgnorePhoneEntities,
+		PfsEnabled:              help.Features.PfsEnabled,
+		SavedGifsLimit:          limits.SavedGifsLimit,
+		StickersFavedLimit:      limits.StickersFavedLimit,
+		PinnedDialogsCountMax:   limits.PinnedDialogsCountMax,
+		PinnedInfolderCountMax:  limits.PinnedInfolderCountMax,
+	}).To_Config()
+}
+
+// HelpNearestDc is the nearestDc of help.getNearestDc.
+func (h *Help) HelpNearestDc() *mtproto.NearestDc {
+	// we have no geoip, every client is nearest to us
+	return mtproto.MakeTLNearestDc(&mtproto.NearestDc{
+		Country:   h.help.Country,
+		ThisDc:    h.help.ThisDc,
+		NearestDc: h.help.ThisDc,
+	}).To_NearestDc()
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/pkg/dcoption"
+)
+
+func TestHelp(t *testing.T) {
+	c := config.HelpConfig{
+		ThisDc:  2,
+		Country: "DE",
+		Expires: time.Hour,
+		Listeners: []dcoption.Listener{
+			{
+				Proto:     "tcp",
+				Addresses: []string{"0.0.0.0:10443"},
+				Options:   dcoption.Options{DcId: 2, IPs: []string{"192.0.2.1", "2001:db8::1"}},
+			},
+			{
+				Proto:     "websocket",
+				Addresses: []string{"0.0.0.0:11443"},
+				Options:   dcoption.Options{DcId: 2},
+			},
+		},
+		Limits:   config.HelpLimits{ChatSizeMax: 200, MessageLengthMax: 4096},
+		Features: config.HelpFeatures{BlockedMode: true, MeUrlPrefix: "https://t.me/"},
+	}
+	if err := c.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHelp(c)
+	if !h.HelpEnabled() {
+		t.Fatalf("HelpEnabled() = false with Listeners")
+	}
+	if NewHelp(config.HelpConfig{Expires: time.Hour}).HelpEnabled() {
+		t.Errorf("HelpEnabled() = true without Listeners")
+	}
+
+	now := time.Unix(1700000000, 0)
+	cfg, ok := mtproto.NewDecodeBuf(encode(h.HelpConfig(now))).Object().(*mtproto.TLConfig)
+	if !ok {
+		t.Fatalf("help.getConfig doesn't encode to a config")
+	}
+	if cfg.GetThisDc() != 2 || cfg.GetWebfileDcId() != 2 || cfg.GetDate() != int32(now.Unix()) || cfg.GetExpires() != int32(now.Unix())+3600 {
+		t.Errorf("config of dc %d, date %d, expires %d", cfg.GetThisDc(), cfg.GetDate(), cfg.GetExpires())
+	}
+	if cfg.GetChatSizeMax() != 200 || cfg.GetMessageLengthMax() != 4096 || !cfg.GetBlockedMode() || cfg.GetMeUrlPrefix() != "https://t.me/" {
+		t.Errorf("config misses the limits or the features: %v", cfg)
+	}
+
+	// the websocket listener has no dc_option
+	wantOptions := []struct {
+		ip   string
+		ipv6 bool
+	}{
+		{"192.0.2.1", false},
+		{"2001:db8::1", true},
+	}
+	if len(cfg.GetDcOptions()) != len(wantOptions) {
+		t.Fatalf("%d dc_options, want %d", len(cfg.GetDcOptions()), len(wantOptions))
+	}
+	for i, o := range cfg.GetDcOptions() {
+		if o.GetId() != 2 || o.GetPort() != 10443 || o.GetIpAddress() != wantOptions[i].ip || o.GetIpv6() != wantOptions[i].ipv6 {
+			t.Errorf("dc_option %d = %v", i, o)
+		}
+	}
+
+	nearest, ok := mtproto.NewDecodeBuf(encode(h.HelpNearestDc())).Object().(*mtproto.TLNearestDc)
+	if !ok {
+		t.Fatalf("help.getNearestDc doesn't encode to a nearestDc")
+	}
+	if nearest.GetCountry() != "DE" || nearest.GetThisDc() != 2 || nearest.GetNearestDc() != 2 {
+		t.Errorf("nearestDc = %v", nearest)
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// RpcRequest is a request of a client, Method is its TL name, e.g. messages.sendMessage.
+type RpcRequest struct {
+	Method   string
+	Object   mtproto.TLObject
+	Metadata *metadata.RpcMetadata
+}
+
+// Backend serves the requests routed to it, a zrpc service or handlers in process.
+type Backend interface {
+	Invoke(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error)
+}
+
+type BackendFunc func(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error)
+
+func (f BackendFunc) Invoke(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error) {
+	return f(ctx, req)
+}
+
+// RpcResultHandler gets the TL serialized result, or rpc_error, of a request, the session
+// sends it to the client the way session.pushRpcResultData does.
+type RpcResultHandler func(ctx context.Context, md *metadata.RpcMetadata, result []byte)
+
+// RpcDoneHandler runs after the request req succeeded and its result went to the RpcResultHandler.
+type RpcDoneHandler func(ctx context.Context, md *metadata.RpcMetadata, req, reply mtproto.TLObject)
+
+type zrpcBackend struct {
+	cli zrpc.Client
+}
+
+// NewZRpcBackend calls the mtproto grpc services, the metadata travels in the grpc metadata.
+func NewZRpcBackend(c zrpc.RpcClientConf) (Backend, error) {
+	c.NonBlock = true
+	cli, err := zrpc.NewClient(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zrpcBackend{cli: cli}, nil
+}
+
+func (b *zrpcBackend) Invoke(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error) {
+	tuple := mtproto.FindRPCContextTuple(req.Object)
+	if tuple == nil {
+		return nil, mtproto.ErrMethodNotImpl
+	}
+
+	ctx, err := metadata.RpcMetadataToOutgoing(ctx, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := tuple.NewReplyFunc()
+	if err = b.cli.Conn().Invoke(ctx, tuple.Method, req.Object, reply); err != nil {
+		return nil, err
+	}
+
+	r, ok := reply.(mtproto.TLObject)
+	if !ok {
+		return nil, fmt.Errorf("%s: invalid reply %T", req.Method, reply)
+	}
+
+	return r, nil
+}
+
+// MethodName returns the TL name of the request obj, e.g. messages.sendMessage.
+func MethodName(obj mtproto.TLObject) (string, bool) {
+	tuple := mtproto.FindRPCContextTuple(obj)
+	if tuple == nil {
+		return "", false
+	}
+
+	method := tuple.Method[strings.LastIndexByte(tuple.Method, '/')+1:]
+	return strings.Replace(method, "_", ".", 1), true
+}
+
+// ClientRequest is what the client sent in one message, Object without the invokeWithLayer,
+// initConnection, invokeWithoutUpdates, invokeAfterMsg(s) and gzip_packed around it.
+type ClientRequest struct {
+	MsgId  int64
+	Object mtproto.TLObject
+	// Layer and LangPack are set if the message carried an invokeWithLayer and initConnection
+	Layer    int32
+	LangPack string
+	// AfterMsgIds are the msg_ids of the invokeAfterMsg(s), it runs after their requests
+	AfterMsgIds []int64
+}
+
+// UnpackClientRequests returns the requests in payload, msg_id:long seq_no:int bytes:int body
+// as the client sent it, one per message of a msg_container.
+func UnpackClientRequests(payload []byte) ([]*ClientRequest, error) {
+	msg := &mtproto.TLMessage2{}
+	if err := msg.Decode(mtproto.NewDecodeBuf(payload)); err != nil {
+		return nil, err
+	}
+
+	msgs := []*mtproto.TLMessage2{msg}
+	if container, ok := msg.Object.(*mtproto.TLMsgContainer); ok {
+		msgs = container.Messages
+	}
+
+	requests := make([]*ClientRequest, 0, len(msgs))
+	for _, m2 := range msgs {
+		req := &ClientRequest{MsgId: m2.MsgId}
+		obj := m2.Object
+		for obj != nil {
+			var query []byte
+			switch r := obj.(type) {
+			case *mtproto.TLInvokeWithLayer:
+				req.Layer = r.Layer
+				query = r.Query
+			case *mtproto.TLInitConnection:
+				req.LangPack = r.LangPack
+				query = r.Query
+			case *mtproto.TLInvokeWithoutUpdates:
+				query = r.Query
+			case *mtproto.TLInvokeAfterMsg:
+				req.AfterMsgIds = append(req.AfterMsgIds, r.MsgId)
+				query = r.Query
+			case *mtproto.TLInvokeAfterMsgs:
+				req.AfterMsgIds = append(req.AfterMsgIds, r.MsgIds...)
+				query = r.Query
+			case *mtproto.TLGzipPacked:
+				obj = r.Obj
+				continue
+			}
+			if query == nil {
+				break
+			}
+			if obj = mtproto.NewDecodeBuf(query).Object(); obj == nil {
+				return nil, fmt.Errorf("msg_id %d: invalid query", m2.MsgId)
+			}
+		}
+		req.Object = obj
+		requests = append(requests, req)
+	}
+
+	return requests, nil
+}
+
+type upstreamRoute struct {
+	method string
+	// service is nil for the Local routes, they resolve local at every call
+	service Backend
+	local   string
+}
+
+func (r *upstreamRoute) match(method string) bool {
+	switch {
+	case r.method == "*":
+		return true
+	case strings.HasSuffix(r.method, ".*"):
+		return strings.HasPrefix(method, r.method[:len(r.method)-1])
+	default:
+		return r.method == method
+	}
+}
+
+// rpcCall is a request of a session the invoker has, waiting for the requests it runs
+// after, for a free slot, or running.
+type rpcCall struct {
+	md  *metadata.RpcMetadata
+	obj mtproto.TLObject
+	// deps is how many requests it still waits for, waiters are those waiting for it
+	deps    int
+	waiters []*rpcCall
+}
+
+// sessionCalls are the requests of a session, at most Queue.MaxInflightRpcs of them run,
+// the others wait in ready in the order they came.
+type sessionCalls struct {
+	running int
+	ready   []*rpcCall
+	// calls are the requests not done yet by msg_id, for the invokeAfterMsg(s) to wait for
+	calls map[int64]*rpcCall
+}
+
+// Invoker routes the requests of the clients by their method, as configured in Upstream.
+// A kitex client plugs in with RegisterBackend and a Local route naming it.
+type Invoker struct {
+	timeout     time.Duration
+	routes      []*upstreamRoute
+	maxInflight int
+
+	callsMu  sync.Mutex
+	sessions map[sessionKey]*sessionCalls
+
+	mu       sync.RWMutex
+	backends map[string]Backend
+	methods  map[string]Backend
+	onResult RpcResultHandler
+	onDone   map[string]RpcDoneHandler
+}
+
+func NewInvoker(c config.UpstreamConfig, q config.QueueConfig) *Invoker {
+	m := &Invoker{
+		timeout:     c.Timeout,
+		routes:      make([]*upstreamRoute, 0, len(c.Routes)),
+		maxInflight: q.MaxInflightRpcs,
+		sessions:    make(map[sessionKey]*sessionCalls),
+		backends:    make(map[string]Backend),
+		methods:     make(map[string]Backend),
+		onDone:      make(map[string]RpcDoneHandler),
+	}
+
+	if m.maxInflight <= 0 {
+		// what the config defaults to
+		m.maxInflight = 128
+	}
+
+	for _, r := range c.Routes {
+		route := &upstreamRoute{
+			method: r.Method,
+			local:  r.Local,
+		}
+		if r.HasService() {
+			b, err := NewZRpcBackend(r.Service)
+			if err != nil {
+				logx.Errorf("upstream %s: new client error: %v", r.Method, err)
+				continue
+			}
+			route.service = b
+		}
+		m.routes = append(m.routes, route)
+	}
+
+	return m
+}
+
+// RegisterBackend makes b serve the routes whose Local is name.
+func (m *Invoker) RegisterBackend(name string, b Backend) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.backends[name] = b
+}
+
+// RegisterMethod makes b serve method, whatever the routes say.
+func (m *Invoker) RegisterMethod(method string, b Backend) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.methods[method] = b
+}
+
+func (m *Invoker) SetRpcResultHandler(h RpcResultHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onResult = h
+}
+
+// RegisterDone makes h run after each request of method that succeeded.
+func (m *Invoker) RegisterDone(method string, h RpcDoneHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onDone[method] = h
+}
+
+func (m *Invoker) backend(method string) (Backend, bool) {
+	m.mu.RLock()
+	b, ok := m.methods[method]
+	m.mu.RUnlock()
+	if ok {
+		return b, true
+	}
+
+	for _, r := range m.routes {
+		if !r.match(method) {
+			continue
+		}
+		if r.service != nil {
+			return r.service, true
+		}
+
+		m.mu.RLock()
+		b, ok := m.backends[r.local]
+		m.mu.RUnlock()
+		return b, ok
+	}
+
+	return nil, false
+}
+
+// Call runs the request obj and waits for its result.
+func (m *Invoker) Call(ctx context.Context, md *metadata.RpcMetadata, obj mtproto.TLObject) (mtproto.TLObject, error) {
+	method, ok := MethodName(obj)
+	if !ok {
+		return nil, mtproto.ErrMethodNotImpl
+	}
+
+	b, ok := m.backend(method)
+	if !ok {
+		logx.WithContext(ctx).Errorf("upstream: no route for %s", method)
+		return nil, mtproto.ErrMethodNotImpl
+	}
+
+	return b.Invoke(ctx, &RpcRequest{
+		Method:   method,
+		Object:   obj,
+		Metadata: md,
+	})
+}
+
+// Invoke runs the request obj in the background, within Upstream.Timeout, its result
+// goes to the RpcResultHandler encoded at md.Layer. It waits for a slot of the session
+// and, for an invokeAfterMsg(s), for the requests of afterMsgIds the session still runs.
+func (m *Invoker) Invoke(md *metadata.RpcMetadata, obj mtproto.TLObject, afterMsgIds ...int64) {
+	var (
+		key  = sessionKey{md.AuthId, md.SessionId}
+		call = &rpcCall{md: md, obj: obj}
+	)
+
+	m.callsMu.Lock()
+	s, ok := m.sessions[key]
+	if !ok {
+		s = &sessionCalls{calls: make(map[int64]*rpcCall)}
+		m.sessions[key] = s
+	}
+	for _, msgId := range afterMsgIds {
+		if dep, ok := s.calls[msgId]; ok {
+			dep.waiters = append(dep.waiters, call)
+			call.deps++
+		}
+	}
+	s.calls[md.ClientMsgId] = call
+	if call.deps == 0 {
+		s.ready = append(s.ready, call)
+	}
+	start := m.startLocked(s)
+	m.callsMu.Unlock()
+
+	for _, c := range start {
+		m.run(key, c)
+	}
+}
+
+// startLocked takes the ready requests of s there is a free slot for.
+func (m *Invoker) startLocked(s *sessionCalls) []*rpcCall {
+	var start []*rpcCall
+	for len(s.ready) > 0 && s.running < m.maxInflight {
+		start = append(start, s.ready[0])
+		s.ready[0] = nil
+		s.ready = s.ready[1:]
+		s.running++
+	}
+
+	return start
+}
+
+func (m *Invoker) run(key sessionKey, call *rpcCall) {
+	threading.GoSafe(func() {
+		defer m.done(key, call)
+		m.invoke(call.md, call.obj)
+	})
+}
+
+// done frees the slot of call and lets the requests waiting for it go on.
+func (m *Invoker) done(key sessionKey, call *rpcCall) {
+	m.callsMu.Lock()
+	s := m.sessions[key]
+	s.running--
+	if s.calls[call.md.ClientMsgId] == call {
+		delete(s.calls, call.md.ClientMsgId)
+	}
+	for _, w := range call.waiters {
+		if w.deps--; w.deps == 0 {
+			s.ready = append(s.ready, w)
+		}
+	}
+	start := m.startLocked(s)
+	if s.running == 0 && len(s.calls) == 0 {
+		delete(m.sessions, key)
+	}
+	m.callsMu.Unlock()
+
+	for _, c := range start {
+		m.run(key, c)
+	}
+}
+
+func (m *Invoker) invoke(md *metadata.RpcMetadata, obj mtproto.TLObject) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	reply, err := m.Call(ctx, md, obj)
+	ok := err == nil
+	if err != nil {
+		logx.WithContext(ctx).Errorf("upstream: invoke %s error: %v", obj, err)
+		reply = mtproto.NewRpcError(err)
+	}
+
+	x := mtproto.NewEncodeBuf(512)
+	if err = reply.Encode(x, md.Layer); err != nil {
+		logx.WithContext(ctx).Errorf("upstream: encode %s error: %v", reply, err)
+		x = mtproto.NewEncodeBuf(64)
+		_ = mtproto.NewRpcError(mtproto.ErrInternalServerError).Encode(x, md.Layer)
+	}
+
+	m.mu.RLock()
+	onResult := m.onResult
+	m.mu.RUnlock()
+	if onResult == nil {
+		logx.WithContext(ctx).Errorf("upstream: no result handler, drop the result of msg_id %d", md.ClientMsgId)
+		return
+	}
+	onResult(ctx, md, x.GetBuf())
+
+	if !ok {
+		return
+	}
+	method, _ := MethodName(obj)
+	m.mu.RLock()
+	onDone := m.onDone[method]
+	m.mu.RUnlock()
+	if onDone != nil {
+		onDone(ctx, md, obj, reply)
+	}
+}
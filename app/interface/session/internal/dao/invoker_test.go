@@ -0,0 +1,512 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/binary"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/mtproto/rpc/metadata"
+	gateway_client "github.com/teamgram/teamgram-server/v2/app/interface/gnetway/client"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/pkg/dcoption"
+)
+
+const testLayer = 166
+
+func encode(obj mtproto.TLObject) []byte {
+	x := mtproto.NewEncodeBuf(64)
+	_ = obj.Encode(x, testLayer)
+	return x.GetBuf()
+}
+
+// wrap is obj the way a client sends its first request, in invokeWithLayer and initConnection.
+func wrap(obj mtproto.TLObject) mtproto.TLObject {
+	return &mtproto.TLInvokeWithLayer{
+		Constructor: mtproto.CRC32_invokeWithLayer,
+		Layer:       testLayer,
+		Query: encode(&mtproto.TLInitConnection{
+			Constructor: mtproto.CRC32_initConnection_c1cd5ea9,
+			ApiId:       4,
+			LangPack:    "android",
+			Query:       encode(obj),
+		}),
+	}
+}
+
+// clientPayload is msg_id+seqno+len+body of the messages, in a msg_container if many.
+func clientPayload(msgId int64, objs ...mtproto.TLObject) []byte {
+	var obj mtproto.TLObject
+	if len(objs) == 1 {
+		obj = objs[0]
+	} else {
+		container := &mtproto.TLMsgContainer{}
+		for i, o := range objs {
+			container.Messages = append(container.Messages, &mtproto.TLMessage2{
+				MsgId:  msgId + int64(i+1)*4,
+				Seqno:  int32(i*2 + 1),
+				Object: o,
+			})
+		}
+		obj = container
+	}
+
+	return encode(&mtproto.TLMessage2{MsgId: msgId, Seqno: 1, Object: obj})
+}
+
+func helpGetConfig() mtproto.TLObject {
+	return &mtproto.TLHelpGetConfig{Constructor: mtproto.CRC32_help_getConfig}
+}
+
+func TestUnpackClientRequests(t *testing.T) {
+	const msgId = 100 << 32
+
+	type want struct {
+		msgId    int64
+		obj      mtproto.TLObject
+		layer    int32
+		langPack string
+		after    []int64
+	}
+	cases := []struct {
+		name    string
+		payload []byte
+		want    []want
+	}{
+		{
+			"plain request",
+			clientPayload(msgId, helpGetConfig()),
+			[]want{{msgId, &mtproto.TLHelpGetConfig{}, 0, "", nil}},
+		},
+		{
+			"first request",
+			clientPayload(msgId, wrap(helpGetConfig())),
+			[]want{{msgId, &mtproto.TLHelpGetConfig{}, testLayer, "android", nil}},
+		},
+		{
+			"invokeAfterMsg",
+			clientPayload(msgId, &mtproto.TLInvokeAfterMsg{
+				Constructor: mtproto.CRC32_invokeAfterMsg,
+				MsgId:       msgId - 4,
+				Query:       encode(wrap(helpGetConfig())),
+			}),
+			[]want{{msgId, &mtproto.TLHelpGetConfig{}, testLayer, "android", []int64{msgId - 4}}},
+		},
+		{
+			"invokeAfterMsgs",
+			clientPayload(msgId, &mtproto.TLInvokeAfterMsgs{
+				Constructor: mtproto.CRC32_invokeAfterMsgs,
+				MsgIds:      []int64{msgId - 8, msgId - 4},
+				Query:       encode(helpGetConfig()),
+			}),
+			[]want{{msgId, &mtproto.TLHelpGetConfig{}, 0, "", []int64{msgId - 8, msgId - 4}}},
+		},
+		{
+			"container",
+			clientPayload(msgId,
+				&mtproto.TLPing{Constructor: mtproto.CRC32_ping, PingId: 7},
+				wrap(&mtproto.TLHelpGetNearestDc{Constructor: mtproto.CRC32_help_getNearestDc}),
+				mtproto.MakeTLMsgsAck(&mtproto.MsgsAck{MsgIds: []int64{1}})),
+			[]want{
+				{msgId + 4, &mtproto.TLPing{}, 0, "", nil},
+				{msgId + 8, &mtproto.TLHelpGetNearestDc{}, testLayer, "android", nil},
+				{msgId + 12, &mtproto.TLMsgsAck{}, 0, "", nil},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests, err := UnpackClientRequests(tc.payload)
+			if err != nil {
+				t.Fatalf("UnpackClientRequests() error: %v", err)
+			}
+			if len(requests) != len(tc.want) {
+				t.Fatalf("got %d requests, want %d", len(requests), len(tc.want))
+			}
+			for i, w := range tc.want {
+				r := requests[i]
+				if r.MsgId != w.msgId || r.Layer != w.layer || r.LangPack != w.langPack || !reflect.DeepEqual(r.AfterMsgIds, w.after) {
+					t.Errorf("request %d = {%d %d %q %v}, want {%d %d %q %v}", i, r.MsgId, r.Layer, r.LangPack, r.AfterMsgIds, w.msgId, w.layer, w.langPack, w.after)
+				}
+				if reflect.TypeOf(r.Object) != reflect.TypeOf(w.obj) {
+					t.Errorf("request %d = %T, want %T", i, r.Object, w.obj)
+				}
+			}
+		})
+	}
+
+	truncated := clientPayload(msgId, wrap(helpGetConfig()))
+	if _, err := UnpackClientRequests(truncated[:len(truncated)-8]); err == nil {
+		t.Errorf("UnpackClientRequests() of a truncated payload succeeded")
+	}
+}
+
+// blockingBackend serves help.getConfig, each request tells it started and waits for
+// its gate, or release if it has none.
+type blockingBackend struct {
+	started chan int64
+	release chan struct{}
+	gates   map[int64]chan struct{}
+
+	mu             sync.Mutex
+	running, peaks int
+}
+
+func newBlockingBackend(gates ...int64) *blockingBackend {
+	b := &blockingBackend{
+		started: make(chan int64, 16),
+		release: make(chan struct{}),
+		gates:   make(map[int64]chan struct{}),
+	}
+	for _, msgId := range gates {
+		b.gates[msgId] = make(chan struct{})
+	}
+
+	return b
+}
+
+func (b *blockingBackend) Invoke(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error) {
+	b.mu.Lock()
+	b.running++
+	b.peaks = max(b.peaks, b.running)
+	b.mu.Unlock()
+	defer func() {
+		b.mu.Lock()
+		b.running--
+		b.mu.Unlock()
+	}()
+
+	b.started <- req.Metadata.ClientMsgId
+	gate, ok := b.gates[req.Metadata.ClientMsgId]
+	if !ok {
+		gate = b.release
+	}
+	<-gate
+
+	// not mtproto.BoolTrue, encoding it writes to it
+	return mtproto.MakeTLBoolTrue(nil).To_Bool(), nil
+}
+
+// nextStarted returns the msg_id of the next request the backend got.
+func (b *blockingBackend) nextStarted(t *testing.T) int64 {
+	t.Helper()
+
+	select {
+	case msgId := <-b.started:
+		return msgId
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no request started")
+		return 0
+	}
+}
+
+// noneStarted checks no other request reaches the backend for a while.
+func (b *blockingBackend) noneStarted(t *testing.T) {
+	t.Helper()
+
+	select {
+	case msgId := <-b.started:
+		t.Fatalf("request of msg_id %d started", msgId)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func newTestInvoker(b Backend, maxInflight int) (*Invoker, chan int64) {
+	m := NewInvoker(config.UpstreamConfig{Timeout: 5 * time.Second}, config.QueueConfig{MaxInflightRpcs: maxInflight})
+	m.RegisterMethod("help.getConfig", b)
+
+	results := make(chan int64, 16)
+	m.SetRpcResultHandler(func(ctx context.Context, md *metadata.RpcMetadata, result []byte) {
+		results <- md.ClientMsgId
+	})
+
+	return m, results
+}
+
+func invokeGetConfig(m *Invoker, sessionId, msgId int64, afterMsgIds ...int64) {
+	m.Invoke(&metadata.RpcMetadata{
+		AuthId:      1,
+		SessionId:   sessionId,
+		ClientMsgId: msgId,
+		Layer:       testLayer,
+	}, helpGetConfig(), afterMsgIds...)
+}
+
+func TestInvokeMaxInflight(t *testing.T) {
+	b := newBlockingBackend()
+	m, results := newTestInvoker(b, 2)
+
+	for i := int64(1); i <= 5; i++ {
+		invokeGetConfig(m, 2, i*4)
+	}
+	b.nextStarted(t)
+	b.nextStarted(t)
+	b.noneStarted(t)
+
+	// the slots are of a session, another one isn't held up
+	invokeGetConfig(m, 3, 4)
+	if msgId := b.nextStarted(t); msgId != 4 {
+		t.Fatalf("request of msg_id %d started, want 4 of the other session", msgId)
+	}
+
+	close(b.release)
+	for i := 0; i < 6; i++ {
+		select {
+		case <-results:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("got %d results, want 6", i)
+		}
+	}
+	if b.peaks > 3 {
+		t.Errorf("%d requests ran at once, want at most 2 of a session", b.peaks)
+	}
+
+	m.callsMu.Lock()
+	defer m.callsMu.Unlock()
+	if len(m.sessions) != 0 {
+		t.Errorf("%d sessions left in the invoker", len(m.sessions))
+	}
+}
+
+func TestInvokeAfterMsg(t *testing.T) {
+	b := newBlockingBackend(4, 8)
+	m, _ := newTestInvoker(b, 8)
+
+	invokeGetConfig(m, 2, 4)
+	if msgId := b.nextStarted(t); msgId != 4 {
+		t.Fatalf("request of msg_id %d started, want 4", msgId)
+	}
+	invokeGetConfig(m, 2, 8, 4)
+	invokeGetConfig(m, 2, 12, 4, 8)
+	// a request done already, or never seen, holds up nothing
+	invokeGetConfig(m, 2, 16, 1000)
+	if msgId := b.nextStarted(t); msgId != 16 {
+		t.Fatalf("request of msg_id %d started, want 16", msgId)
+	}
+	b.noneStarted(t)
+
+	close(b.gates[4])
+	if msgId := b.nextStarted(t); msgId != 8 {
+		t.Fatalf("request of msg_id %d started, want 8 after 4", msgId)
+	}
+	b.noneStarted(t)
+
+	close(b.gates[8])
+	if msgId := b.nextStarted(t); msgId != 12 {
+		t.Fatalf("request of msg_id %d started, want 12 after 4 and 8", msgId)
+	}
+	close(b.release)
+}
+
+// gatewayRecorder is a gnetway keeping what the session asks it to write.
+type gatewayRecorder struct {
+	gateway_client.GatewayClient
+	payloads chan *gateway.TLGatewaySendDataToGateway
+}
+
+func (g *gatewayRecorder) GatewaySendDataToGateway(ctx context.Context, in *gateway.TLGatewaySendDataToGateway) (*mtproto.Bool, error) {
+	g.payloads <- in
+	return mtproto.ToBool(true), nil
+}
+
+func (g *gatewayRecorder) next(t *testing.T) *gateway.TLGatewaySendDataToGateway {
+	t.Helper()
+
+	select {
+	case in := <-g.payloads:
+		return in
+	case <-time.After(5 * time.Second):
+		t.Fatalf("nothing sent to the gateway")
+		return nil
+	}
+}
+
+// serverMessage is salt+session_id+msg_id+seqno+len+body as the session sends it.
+type serverMessage struct {
+	salt, sessionId, msgId int64
+	seqNo                  int32
+	body                   []byte
+}
+
+func parseServerMessage(t *testing.T, b []byte) serverMessage {
+	t.Helper()
+
+	if len(b) < 32 || int(binary.LittleEndian.Uint32(b[28:])) != len(b)-32 {
+		t.Fatalf("invalid server message of %d bytes", len(b))
+	}
+	return serverMessage{
+		salt:      int64(binary.LittleEndian.Uint64(b)),
+		sessionId: int64(binary.LittleEndian.Uint64(b[8:])),
+		msgId:     int64(binary.LittleEndian.Uint64(b[16:])),
+		seqNo:     int32(binary.LittleEndian.Uint32(b[24:])),
+		body:      b[32:],
+	}
+}
+
+// TestInvokeEndToEnd runs help.getConfig the way the session does: the request of the client
+// is unpacked and invoked, its result framed as an rpc_result and written by the gnetway.
+func TestInvokeEndToEnd(t *testing.T) {
+	const (
+		permAuthKeyId = 1
+		sessionId     = 2
+		salt          = 3
+		msgId         = 100 << 32
+	)
+
+	listeners := []dcoption.Listener{{
+		Proto:     "tcp",
+		Addresses: []string{"0.0.0.0:10443"},
+		Options:   dcoption.Options{DcId: 2, IPs: []string{"192.0.2.1", "2001:db8::1"}},
+	}}
+
+	d := New(config.Config{
+		Upstream: config.UpstreamConfig{
+			Timeout: 5 * time.Second,
+			Routes:  []config.UpstreamRoute{{Method: "help.*", Local: "help"}},
+		},
+		Help: config.HelpConfig{ThisDc: 2, Expires: time.Hour, Listeners: listeners},
+	}, "session")
+	gw := &gatewayRecorder{payloads: make(chan *gateway.TLGatewaySendDataToGateway, 4)}
+	d.SetGatewayClient("gateway", gw)
+
+	d.RegisterBackend("help", BackendFunc(func(ctx context.Context, req *RpcRequest) (mtproto.TLObject, error) {
+		if _, ok := req.Object.(*mtproto.TLHelpGetConfig); !ok {
+			return nil, mtproto.ErrMethodNotImpl
+		}
+		if req.Metadata.Layer != testLayer || req.Metadata.Langpack != "android" {
+			t.Errorf("metadata %v misses the layer or the lang pack", req.Metadata)
+		}
+		return d.HelpConfig(time.Now()), nil
+	}))
+	// what core.PushRpcResult and session.pushRpcResultData do
+	d.SetRpcResultHandler(func(ctx context.Context, md *metadata.RpcMetadata, result []byte) {
+		x := mtproto.NewEncodeBuf(12 + len(result))
+		x.Int(int32(mtproto.CRC32_rpc_result))
+		x.Long(md.ClientMsgId)
+		x.Bytes(result)
+		if _, err := d.SendToSession(ctx, md.PermAuthKeyId, md.AuthId, md.SessionId, x.GetBuf(), true); err != nil {
+			t.Errorf("SendToSession() error: %v", err)
+		}
+	})
+
+	payload := clientPayload(msgId, wrap(helpGetConfig()))
+	d.ObserveSessionData(permAuthKeyId, permAuthKeyId, sessionId, "gateway", salt, payload)
+	requests, err := UnpackClientRequests(payload)
+	if err != nil || len(requests) != 1 {
+		t.Fatalf("UnpackClientRequests() = %v, %v", requests, err)
+	}
+	d.Invoke(&metadata.RpcMetadata{
+		ServerId:      "gateway",
+		AuthId:        permAuthKeyId,
+		SessionId:     sessionId,
+		ClientMsgId:   requests[0].MsgId,
+		Layer:         requests[0].Layer,
+		Langpack:      requests[0].LangPack,
+		PermAuthKeyId: permAuthKeyId,
+	}, requests[0].Object)
+
+	in := gw.next(t)
+	if in.AuthKeyId != permAuthKeyId || in.SessionId != sessionId {
+		t.Fatalf("sent to auth_key_id %d session_id %d", in.AuthKeyId, in.SessionId)
+	}
+	m := parseServerMessage(t, in.Payload)
+	if m.salt != salt || m.sessionId != sessionId || m.msgId&3 != 1 || m.seqNo != 1 {
+		t.Fatalf("message {salt %d, session_id %d, msg_id %d, seqno %d}", m.salt, m.sessionId, m.msgId, m.seqNo)
+	}
+	if crc := mtproto.TLConstructor(binary.LittleEndian.Uint32(m.body)); crc != mtproto.CRC32_rpc_result {
+		t.Fatalf("body is %d, want an rpc_result", crc)
+	}
+	if reqMsgId := int64(binary.LittleEndian.Uint64(m.body[4:])); reqMsgId != msgId {
+		t.Fatalf("rpc_result of msg_id %d, want %d", reqMsgId, msgId)
+	}
+	result, ok := mtproto.NewDecodeBuf(m.body[12:]).Object().(*mtproto.TLConfig)
+	if !ok {
+		t.Fatalf("rpc_result isn't a config")
+	}
+	if result.GetThisDc() != 2 || len(result.GetDcOptions()) != 2 {
+		t.Fatalf("config of dc %d with %d dc_options", result.GetThisDc(), len(result.GetDcOptions()))
+	}
+	for i, o := range result.GetDcOptions() {
+		if o.GetId() != 2 || o.GetPort() != 10443 || o.GetIpv6() != (i == 1) {
+			t.Errorf("dc_option %d = %v", i, o)
+		}
+	}
+
+	// a method nobody serves gets an rpc_error
+	d.Invoke(&metadata.RpcMetadata{
+		AuthId:        permAuthKeyId,
+		SessionId:     sessionId,
+		ClientMsgId:   msgId + 4,
+		Layer:         testLayer,
+		PermAuthKeyId: permAuthKeyId,
+	}, &mtproto.TLHelpGetNearestDc{Constructor: mtproto.CRC32_help_getNearestDc})
+
+	m = parseServerMessage(t, gw.next(t).Payload)
+	rpcError, ok := mtproto.NewDecodeBuf(m.body[12:]).Object().(*mtproto.TLRpcError)
+	if !ok || rpcError.GetErrorMessage() != "METHOD_NOT_IMPL" {
+		t.Fatalf("rpc_result = %v, want METHOD_NOT_IMPL", rpcError)
+	}
+	if m.seqNo != 3 {
+		t.Fatalf("seqno = %d, want 3", m.seqNo)
+	}
+}
+
+func TestSendToSession(t *testing.T) {
+	d := New(config.Config{}, "session")
+	gw := &gatewayRecorder{payloads: make(chan *gateway.TLGatewaySendDataToGateway, 4)}
+	d.SetGatewayClient("gateway", gw)
+
+	if ok, err := d.SendToSession(context.Background(), 1, 1, 2, []byte{1, 2, 3, 4}, true); ok || err != nil {
+		t.Fatalf("SendToSession() to no session = %v, %v", ok, err)
+	}
+
+	d.OpenSession(1, 1, 2, "gateway")
+	cases := []struct {
+		contentRelated bool
+		wantSeqNo      int32
+	}{
+		{true, 1},
+		{false, 2},
+		{true, 3},
+		{true, 5},
+		{false, 6},
+	}
+
+	var lastMsgId int64
+	for i, tc := range cases {
+		if ok, err := d.SendToSession(context.Background(), 1, 1, 2, []byte{1, 2, 3, 4}, tc.contentRelated); !ok || err != nil {
+			t.Fatalf("%d: SendToSession() = %v, %v", i, ok, err)
+		}
+		m := parseServerMessage(t, gw.next(t).Payload)
+		if m.seqNo != tc.wantSeqNo {
+			t.Errorf("%d: seqno = %d, want %d", i, m.seqNo, tc.wantSeqNo)
+		}
+		if m.msgId&3 != 1 || m.msgId <= lastMsgId {
+			t.Errorf("%d: msg_id %d after %d", i, m.msgId, lastMsgId)
+		}
+		lastMsgId = m.msgId
+	}
+}
@@ -0,0 +1,497 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// LiveSession is a session of a client with a connection on the gnetway ServerId.
+type LiveSession struct {
+	PermAuthKeyId int64
+	AuthKeyId     int64
+	SessionId     int64
+	ServerId      string
+	// Salt is the last server salt the client sent, Layer its last invokeWithLayer
+	Salt  int64
+	Layer int32
+	// WithoutUpdates is set once the client used invokeWithoutUpdates in the session
+	WithoutUpdates bool
+
+	Conns     int
+	LastMsgId int64
+	SeqNo     int32
+}
+
+// DeviceToken is a push token a client registered with account.registerDevice.
+type DeviceToken struct {
+	TokenType  int32
+	Token      string
+	AppSandbox bool   `json:",omitempty"`
+	Secret     []byte `json:",omitempty"`
+	NoMuted    bool   `json:",omitempty"`
+}
+
+type sessionKey struct {
+	authKeyId int64
+	sessionId int64
+}
+
+// LiveSessions keeps the live sessions by owning auth key, the perm key of a temp key,
+// to reach every session of a perm key, and the device tokens of the key to reach it
+// offline. It hands them off with the auth keys.
+type LiveSessions struct {
+	mu     sync.Mutex
+	owners map[int64]map[sessionKey]*LiveSession
+	tokens map[int64][]DeviceToken
+}
+
+func NewLiveSessions() *LiveSessions {
+	return &LiveSessions{
+		owners: make(map[int64]map[sessionKey]*LiveSession),
+		tokens: make(map[int64][]DeviceToken),
+	}
+}
+
+func (m *LiveSessions) getOrCreateLocked(permAuthKeyId, authKeyId, sessionId int64, serverId string) *LiveSession {
+	ownerId := ownerAuthKeyId(authKeyId, permAuthKeyId)
+	sessions, ok := m.owners[ownerId]
+	if !ok {
+		sessions = make(map[sessionKey]*LiveSession)
+		m.owners[ownerId] = sessions
+	}
+
+	k := sessionKey{authKeyId: authKeyId, sessionId: sessionId}
+	v, ok := sessions[k]
+	if !ok {
+		v = &LiveSession{
+			PermAuthKeyId: permAuthKeyId,
+			AuthKeyId:     authKeyId,
+			SessionId:     sessionId,
+		}
+		sessions[k] = v
+	}
+	// the client may have moved to another gnetway
+	v.ServerId = serverId
+
+	return v
+}
+
+// OpenSession records a connection of the session sessionId on the gnetway serverId.
+func (m *LiveSessions) OpenSession(permAuthKeyId, authKeyId, sessionId int64, serverId string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.getOrCreateLocked(permAuthKeyId, authKeyId, sessionId, serverId).Conns++
+}
+
+// CloseSession drops a connection of the session sessionId, the session with its last one.
+func (m *LiveSessions) CloseSession(permAuthKeyId, authKeyId, sessionId int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ownerId := ownerAuthKeyId(authKeyId, permAuthKeyId)
+	sessions, ok := m.owners[ownerId]
+	if !ok {
+		return
+	}
+
+	k := sessionKey{authKeyId: authKeyId, sessionId: sessionId}
+	if v, ok2 := sessions[k]; ok2 {
+		if v.Conns--; v.Conns <= 0 {
+			delete(sessions, k)
+		}
+	}
+	if len(sessions) == 0 {
+		delete(m.owners, ownerId)
+	}
+}
+
+// ObserveSessionData learns the salt, layer, invokeWithoutUpdates and device tokens of a
+// session from the data a client sent in it, payload is msg_id:long seq_no:int bytes:int body.
+// A session we didn't know, e.g. after a restart, is recorded too. It reports whether the
+// client sent destroy_auth_key.
+func (m *LiveSessions) ObserveSessionData(permAuthKeyId, authKeyId, sessionId int64, serverId string, salt int64, payload []byte) (destroyAuthKey bool) {
+	info := inspectSessionData(payload)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v := m.getOrCreateLocked(permAuthKeyId, authKeyId, sessionId, serverId)
+	if v.Conns == 0 {
+		v.Conns = 1
+	}
+	v.Salt = salt
+	if info.layer != 0 {
+		v.Layer = info.layer
+	}
+	if info.withoutUpdates {
+		v.WithoutUpdates = true
+	}
+
+	ownerId := ownerAuthKeyId(authKeyId, permAuthKeyId)
+	for _, d := range info.devices {
+		tokens := m.tokens[ownerId][:0:0]
+		for _, t := range m.tokens[ownerId] {
+			if t.TokenType != d.token.TokenType || t.Token != d.token.Token {
+				tokens = append(tokens, t)
+			}
+		}
+		if d.register {
+			tokens = append(tokens, d.token)
+		}
+		if len(tokens) == 0 {
+			delete(m.tokens, ownerId)
+		} else {
+			m.tokens[ownerId] = tokens
+		}
+	}
+
+	return info.destroyAuthKey
+}
+
+// GetDeviceTokens returns the device tokens registered with the perm key permAuthKeyId.
+func (m *LiveSessions) GetDeviceTokens(permAuthKeyId int64) []DeviceToken {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]DeviceToken(nil), m.tokens[permAuthKeyId]...)
+}
+
+// RemoveLiveSessions drops the sessions of authKeyId, or of all keys bound to the perm key
+// authKeyId and its device tokens.
+func (m *LiveSessions) RemoveLiveSessions(authKeyId int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.owners, authKeyId)
+	delete(m.tokens, authKeyId)
+	for ownerId, sessions := range m.owners {
+		for k := range sessions {
+			if k.authKeyId == authKeyId {
+				delete(sessions, k)
+			}
+		}
+		if len(sessions) == 0 {
+			delete(m.owners, ownerId)
+		}
+	}
+}
+
+// RebindLiveSessions files the sessions of the temp key tempAuthKeyId, opened before
+// auth.bindTempAuthKey, and its device tokens under the perm key permAuthKeyId, for
+// GetLiveSessions(permAuthKeyId) to find them.
+func (m *LiveSessions) RebindLiveSessions(tempAuthKeyId, permAuthKeyId int64) {
+	if permAuthKeyId == 0 || permAuthKeyId == tempAuthKeyId {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if sessions, ok := m.owners[tempAuthKeyId]; ok {
+		for k, v := range sessions {
+			if k.authKeyId != tempAuthKeyId {
+				continue
+			}
+			delete(sessions, k)
+			v.PermAuthKeyId = permAuthKeyId
+			m.mergeLocked(permAuthKeyId, v)
+		}
+		if len(sessions) == 0 {
+			delete(m.owners, tempAuthKeyId)
+		}
+	}
+
+	m.addTokensLocked(permAuthKeyId, m.tokens[tempAuthKeyId])
+	delete(m.tokens, tempAuthKeyId)
+}
+
+// GetLiveSessions returns a copy of every session of the perm key permAuthKeyId.
+func (m *LiveSessions) GetLiveSessions(permAuthKeyId int64) []LiveSession {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	sessions := m.owners[permAuthKeyId]
+	r := make([]LiveSession, 0, len(sessions))
+	for _, v := range sessions {
+		r = append(r, *v)
+	}
+
+	return r
+}
+
+// NextMessage returns the msg_id and seq_no of the next message we send in a session,
+// content related, not in response to the client.
+func (m *LiveSessions) NextMessage(permAuthKeyId, authKeyId, sessionId int64) (msgId int64, seqNo int32, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.owners[ownerAuthKeyId(authKeyId, permAuthKeyId)][sessionKey{authKeyId: authKeyId, sessionId: sessionId}]
+	if !ok {
+		return 0, 0, false
+	}
+
+	msgId, seqNo = v.nextLocked(3, true)
+	return msgId, seqNo, true
+}
+
+// NextResponse returns a copy of the session with the msg_id and seq_no of a message we
+// send in response to the client, e.g. an rpc_result, content related or not, e.g. a pong.
+func (m *LiveSessions) NextResponse(permAuthKeyId, authKeyId, sessionId int64, contentRelated bool) (v LiveSession, msgId int64, seqNo int32, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.owners[ownerAuthKeyId(authKeyId, permAuthKeyId)][sessionKey{authKeyId: authKeyId, sessionId: sessionId}]
+	if !ok {
+		return LiveSession{}, 0, 0, false
+	}
+
+	msgId, seqNo = s.nextLocked(1, contentRelated)
+	return *s, msgId, seqNo, true
+}
+
+// GetLiveSession returns a copy of the session sessionId of authKeyId.
+func (m *LiveSessions) GetLiveSession(permAuthKeyId, authKeyId, sessionId int64) (LiveSession, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.owners[ownerAuthKeyId(authKeyId, permAuthKeyId)][sessionKey{authKeyId: authKeyId, sessionId: sessionId}]
+	if !ok {
+		return LiveSession{}, false
+	}
+
+	return *v, true
+}
+
+// nextLocked returns the next msg_id of the session, unixtime << 32 with the low bits
+// low, 1 in response to the client and 3 otherwise, and its seq_no.
+func (v *LiveSession) nextLocked(low int64, contentRelated bool) (msgId int64, seqNo int32) {
+	now := time.Now().UnixNano()
+	msgId = (now/1e9)<<32 | (now%1e9)&^3 | low
+	if msgId <= v.LastMsgId {
+		msgId = v.LastMsgId&^3 + 4 | low
+	}
+	v.LastMsgId = msgId
+
+	seqNo = v.SeqNo * 2
+	if contentRelated {
+		seqNo++
+		v.SeqNo++
+	}
+
+	return msgId, seqNo
+}
+
+type liveSessionsState struct {
+	Sessions     []*LiveSession `json:",omitempty"`
+	DeviceTokens []DeviceToken  `json:",omitempty"`
+}
+
+// SessionStateKeys, ExportSessionState and ImportSessionState move the sessions and the
+// device tokens of an owning auth key to the session node taking it over.
+func (m *LiveSessions) SessionStateKeys() []int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ids := make([]int64, 0, len(m.owners)+len(m.tokens))
+	for ownerId := range m.owners {
+		ids = append(ids, ownerId)
+	}
+	for ownerId := range m.tokens {
+		if _, ok := m.owners[ownerId]; !ok {
+			ids = append(ids, ownerId)
+		}
+	}
+
+	return ids
+}
+
+func (m *LiveSessions) ExportSessionState(authKeyId int64) ([]byte, error) {
+	m.mu.Lock()
+	sessions := m.owners[authKeyId]
+	tokens := m.tokens[authKeyId]
+	delete(m.owners, authKeyId)
+	delete(m.tokens, authKeyId)
+	m.mu.Unlock()
+
+	if len(sessions) == 0 && len(tokens) == 0 {
+		return nil, nil
+	}
+
+	st := liveSessionsState{
+		Sessions:     make([]*LiveSession, 0, len(sessions)),
+		DeviceTokens: tokens,
+	}
+	for _, v := range sessions {
+		st.Sessions = append(st.Sessions, v)
+	}
+
+	return json.Marshal(&st)
+}
+
+// ImportSessionState merges the state of authKeyId into ours, a session may be known here
+// already, e.g. opened by a call forwarded while the state was on its way.
+func (m *LiveSessions) ImportSessionState(authKeyId int64, state []byte) error {
+	var st liveSessionsState
+	if err := json.Unmarshal(state, &st); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.addTokensLocked(authKeyId, st.DeviceTokens)
+	for _, v := range st.Sessions {
+		m.mergeLocked(authKeyId, v)
+	}
+
+	return nil
+}
+
+// addTokensLocked adds the device tokens of ownerId it doesn't have yet.
+func (m *LiveSessions) addTokensLocked(ownerId int64, tokens []DeviceToken) {
+	for _, t := range tokens {
+		known := false
+		for _, t2 := range m.tokens[ownerId] {
+			if t2.TokenType == t.TokenType && t2.Token == t.Token {
+				known = true
+				break
+			}
+		}
+		if !known {
+			m.tokens[ownerId] = append(m.tokens[ownerId], t)
+		}
+	}
+}
+
+// mergeLocked files the session v under ownerId, merged into the one known there already.
+func (m *LiveSessions) mergeLocked(ownerId int64, v *LiveSession) {
+	owned, ok := m.owners[ownerId]
+	if !ok {
+		owned = make(map[sessionKey]*LiveSession)
+		m.owners[ownerId] = owned
+	}
+
+	k := sessionKey{authKeyId: v.AuthKeyId, sessionId: v.SessionId}
+	known, ok := owned[k]
+	if !ok {
+		owned[k] = v
+		return
+	}
+
+	// what we saw here is newer, but for the messages we sent in the session
+	known.Conns += v.Conns
+	if known.Salt == 0 {
+		known.Salt = v.Salt
+	}
+	if known.Layer == 0 {
+		known.Layer = v.Layer
+	}
+	known.WithoutUpdates = known.WithoutUpdates || v.WithoutUpdates
+	if v.LastMsgId > known.LastMsgId {
+		known.LastMsgId = v.LastMsgId
+	}
+	if v.SeqNo > known.SeqNo {
+		known.SeqNo = v.SeqNo
+	}
+}
+
+type deviceChange struct {
+	register bool
+	token    DeviceToken
+}
+
+type sessionDataInfo struct {
+	layer          int32
+	withoutUpdates bool
+	devices        []deviceChange
+	destroyAuthKey bool
+}
+
+// inspectSessionData finds the layer, an invokeWithoutUpdates, the device (un)registrations
+// and a destroy_auth_key in the messages of payload.
+func inspectSessionData(payload []byte) (info sessionDataInfo) {
+	msg := &mtproto.TLMessage2{}
+	if err := msg.Decode(mtproto.NewDecodeBuf(payload)); err != nil {
+		logx.Debugf("inspectSessionData - decode error: %v", err)
+		return
+	}
+
+	msgs := []*mtproto.TLMessage2{msg}
+	if container, ok := msg.Object.(*mtproto.TLMsgContainer); ok {
+		msgs = container.Messages
+	}
+
+	for _, m2 := range msgs {
+		obj := m2.Object
+		for obj != nil {
+			var query []byte
+			switch r := obj.(type) {
+			case *mtproto.TLInvokeWithLayer:
+				info.layer = r.Layer
+				query = r.Query
+			case *mtproto.TLInvokeWithoutUpdates:
+				info.withoutUpdates = true
+				query = r.Query
+			case *mtproto.TLInitConnection:
+				query = r.Query
+			case *mtproto.TLInvokeAfterMsg:
+				query = r.Query
+			case *mtproto.TLInvokeAfterMsgs:
+				query = r.Query
+			case *mtproto.TLGzipPacked:
+				obj = r.Obj
+				continue
+			case *mtproto.TLAccountRegisterDevice:
+				info.devices = append(info.devices, deviceChange{
+					register: true,
+					token: DeviceToken{
+						TokenType:  r.TokenType,
+						Token:      r.Token,
+						AppSandbox: mtproto.FromBool(r.AppSandbox),
+						Secret:     r.Secret,
+						NoMuted:    r.NoMuted,
+					},
+				})
+			case *mtproto.TLDestroyAuthKey:
+				info.destroyAuthKey = true
+			case *mtproto.TLAccountUnregisterDevice:
+				info.devices = append(info.devices, deviceChange{
+					token: DeviceToken{
+						TokenType: r.TokenType,
+						Token:     r.Token,
+					},
+				})
+			}
+			if query == nil {
+				break
+			}
+			obj = mtproto.NewDecodeBuf(query).Object()
+		}
+	}
+
+	return
+}
@@ -0,0 +1,177 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+)
+
+func newTestDao(serverId string) *Dao {
+	d := New(config.Config{}, serverId)
+	d.SetSessionStateProvider(d.LiveSessions)
+
+	return d
+}
+
+func sortedSessions(d *Dao, permAuthKeyId int64) []LiveSession {
+	sessions := d.GetLiveSessions(permAuthKeyId)
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].SessionId < sessions[j].SessionId })
+
+	return sessions
+}
+
+// TestHandoffLiveSessions hands the sessions of a perm key and of a temp key bound to it
+// off to a node that opened one of them meanwhile, for a call forwarded to it.
+func TestHandoffLiveSessions(t *testing.T) {
+	const (
+		permAuthKeyId = 10
+		tempAuthKeyId = 11
+	)
+
+	from := newTestDao("session-a")
+	from.AddAuthKeyGateway(permAuthKeyId, permAuthKeyId, "gateway-1")
+	from.OpenSession(permAuthKeyId, permAuthKeyId, 1, "gateway-1")
+	from.ObserveSessionData(permAuthKeyId, permAuthKeyId, 1, "gateway-1", 100, clientPayload(1<<32, wrap(helpGetConfig())))
+	from.AddAuthKeyGateway(permAuthKeyId, tempAuthKeyId, "gateway-1")
+	from.OpenSession(permAuthKeyId, tempAuthKeyId, 2, "gateway-1")
+	lastMsgId, _, _ := from.NextMessage(permAuthKeyId, permAuthKeyId, 1)
+	from.NextMessage(permAuthKeyId, permAuthKeyId, 1)
+	from.tokens[permAuthKeyId] = []DeviceToken{{TokenType: 2, Token: "fcm"}, {TokenType: 1, Token: "apns"}}
+
+	to := newTestDao("session-b")
+	to.OpenSession(permAuthKeyId, permAuthKeyId, 1, "gateway-2")
+	to.tokens[permAuthKeyId] = []DeviceToken{{TokenType: 2, Token: "fcm", NoMuted: true}}
+
+	owners := map[int64]struct{}{permAuthKeyId: {}}
+	if imported := to.Import(&session.SessionHandoffChunk{FromServerId: "session-a", States: from.export(owners)}); imported != 1 {
+		t.Fatalf("Import() = %d, want 1", imported)
+	}
+
+	if got := from.GetLiveSessions(permAuthKeyId); len(got) != 0 {
+		t.Fatalf("the sessions stayed on the node handing them off: %v", got)
+	}
+
+	got := sortedSessions(to, permAuthKeyId)
+	if len(got) != 2 {
+		t.Fatalf("got %d sessions, want 2", len(got))
+	}
+	cases := []struct {
+		got       LiveSession
+		authKeyId int64
+		serverId  string
+		salt      int64
+		layer     int32
+		conns     int
+		seqNo     int32
+	}{
+		// merged with the one opened here, on the gnetway the client is on now
+		{got[0], permAuthKeyId, "gateway-2", 100, testLayer, 2, 2},
+		{got[1], tempAuthKeyId, "gateway-1", 0, 0, 1, 0},
+	}
+	for i, tc := range cases {
+		v := tc.got
+		if v.AuthKeyId != tc.authKeyId || v.ServerId != tc.serverId || v.Salt != tc.salt || v.Layer != tc.layer || v.Conns != tc.conns || v.SeqNo != tc.seqNo {
+			t.Errorf("session %d = %+v", i, v)
+		}
+	}
+
+	// the messages go on where the other node stopped
+	msgId, seqNo, ok := to.NextMessage(permAuthKeyId, permAuthKeyId, 1)
+	if !ok || msgId <= lastMsgId || seqNo != 5 {
+		t.Errorf("NextMessage() = %d, %d, %v, want after %d, 5", msgId, seqNo, ok, lastMsgId)
+	}
+
+	tokens := to.GetDeviceTokens(permAuthKeyId)
+	if len(tokens) != 2 || !tokens[0].NoMuted || tokens[1].Token != "apns" {
+		t.Errorf("device tokens = %+v", tokens)
+	}
+
+	// the gnetways came along with the sessions
+	if ids := to.OwnedAuthKeyIds(); len(ids) != 1 || ids[0] != permAuthKeyId {
+		t.Errorf("OwnedAuthKeyIds() = %v", ids)
+	}
+}
+
+// TestRebindSessions binds a temp key whose sessions were opened before auth.bindTempAuthKey,
+// one of them seen again with the perm key meanwhile.
+func TestRebindSessions(t *testing.T) {
+	const (
+		permAuthKeyId = 10
+		tempAuthKeyId = 11
+	)
+
+	d := newTestDao("session-a")
+	d.AddAuthKeyGateway(0, tempAuthKeyId, "gateway-1")
+	d.OpenSession(0, tempAuthKeyId, 1, "gateway-1")
+	d.ObserveSessionData(0, tempAuthKeyId, 1, "gateway-1", 100, clientPayload(1<<32, wrap(helpGetConfig())))
+	lastMsgId, _, _ := d.NextMessage(0, tempAuthKeyId, 1)
+	d.OpenSession(0, tempAuthKeyId, 2, "gateway-1")
+	d.OpenSession(permAuthKeyId, tempAuthKeyId, 2, "gateway-2")
+	d.OpenSession(permAuthKeyId, permAuthKeyId, 3, "gateway-1")
+	d.tokens[tempAuthKeyId] = []DeviceToken{{TokenType: 2, Token: "fcm"}}
+
+	if got := d.GetLiveSessions(permAuthKeyId); len(got) != 2 {
+		t.Fatalf("got %d sessions of the perm key before the bind, want 2", len(got))
+	}
+
+	d.RebindSessions(tempAuthKeyId, permAuthKeyId)
+
+	if got := d.GetLiveSessions(tempAuthKeyId); len(got) != 0 {
+		t.Fatalf("sessions left under the temp key: %v", got)
+	}
+	got := sortedSessions(d, permAuthKeyId)
+	if len(got) != 3 {
+		t.Fatalf("got %d sessions, want 3", len(got))
+	}
+	cases := []struct {
+		got       LiveSession
+		authKeyId int64
+		serverId  string
+		salt      int64
+		conns     int
+	}{
+		{got[0], tempAuthKeyId, "gateway-1", 100, 1},
+		// merged with the one seen with the perm key
+		{got[1], tempAuthKeyId, "gateway-2", 0, 2},
+		{got[2], permAuthKeyId, "gateway-1", 0, 1},
+	}
+	for i, tc := range cases {
+		v := tc.got
+		if v.PermAuthKeyId != permAuthKeyId || v.AuthKeyId != tc.authKeyId || v.ServerId != tc.serverId || v.Salt != tc.salt || v.Conns != tc.conns {
+			t.Errorf("session %d = %+v", i, v)
+		}
+	}
+
+	// the messages go on in the session, looked up with the perm key now
+	msgId, seqNo, ok := d.NextMessage(permAuthKeyId, tempAuthKeyId, 1)
+	if !ok || msgId <= lastMsgId || seqNo != 3 {
+		t.Errorf("NextMessage() = %d, %d, %v, want after %d, 3", msgId, seqNo, ok, lastMsgId)
+	}
+
+	if tokens := d.GetDeviceTokens(permAuthKeyId); len(tokens) != 1 || tokens[0].Token != "fcm" {
+		t.Errorf("device tokens = %+v", tokens)
+	}
+	if ids := d.OwnedAuthKeyIds(); len(ids) != 1 || ids[0] != permAuthKeyId {
+		t.Errorf("OwnedAuthKeyIds() = %v", ids)
+	}
+}
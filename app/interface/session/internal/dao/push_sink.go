@@ -0,0 +1,208 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	ErrPushQueueFull  = errors.New("push: queue full")
+	ErrPushSinkClosed = errors.New("push: sink closed")
+)
+
+// UpdatesSummary tells what the updates are about without their content, enough for a
+// notification to say there is something new.
+type UpdatesSummary struct {
+	Predicate string
+	Updates   []string `json:",omitempty"`
+	UserId    int64    `json:",omitempty"`
+	ChatId    int64    `json:",omitempty"`
+	FromId    int64    `json:",omitempty"`
+	Date      int32    `json:",omitempty"`
+	Seq       int32    `json:",omitempty"`
+}
+
+// SummarizeUpdates returns the summary of updates.
+func SummarizeUpdates(updates *mtproto.Updates) UpdatesSummary {
+	s := UpdatesSummary{
+		Predicate: updates.GetPredicateName(),
+		UserId:    updates.GetUserId(),
+		ChatId:    updates.GetChatId(),
+		FromId:    updates.GetFromId(),
+		Date:      updates.GetDate(),
+		Seq:       updates.GetSeq(),
+	}
+	if u := updates.GetUpdate(); u != nil {
+		s.Updates = append(s.Updates, u.GetPredicateName())
+	}
+	for _, u := range updates.GetUpdates() {
+		s.Updates = append(s.Updates, u.GetPredicateName())
+	}
+
+	return s
+}
+
+// PushNotification is an update flagged notification no session of PermAuthKeyId got.
+type PushNotification struct {
+	PermAuthKeyId int64
+	Summary       UpdatesSummary
+	DeviceTokens  []DeviceToken
+	Time          time.Time
+}
+
+// PushSink delivers the notifications of the clients offline, e.g. over APNs or FCM.
+type PushSink interface {
+	Push(ctx context.Context, n *PushNotification) error
+}
+
+// FilePushSink appends the notifications to a file as json lines, a queue of QueueSize
+// keeps the writes off the callers.
+type FilePushSink struct {
+	mu     sync.RWMutex
+	closed bool
+	queue  chan *PushNotification
+	f      *os.File
+	wg     sync.WaitGroup
+}
+
+func NewFilePushSink(c config.PushConfig) (*FilePushSink, error) {
+	f, err := os.OpenFile(c.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FilePushSink{
+		queue: make(chan *PushNotification, c.QueueSize),
+		f:     f,
+	}
+	s.wg.Add(1)
+	go s.writeLoop()
+
+	return s, nil
+}
+
+func (s *FilePushSink) Push(ctx context.Context, n *PushNotification) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return ErrPushSinkClosed
+	}
+	select {
+	case s.queue <- n:
+		return nil
+	default:
+		return ErrPushQueueFull
+	}
+}
+
+func (s *FilePushSink) writeLoop() {
+	defer s.wg.Done()
+
+	enc := json.NewEncoder(s.f)
+	for n := range s.queue {
+		if err := enc.Encode(n); err != nil {
+			logx.Errorf("push - write perm_auth_key_id(%d) to %s error: %v", n.PermAuthKeyId, s.f.Name(), err)
+		}
+	}
+}
+
+// Close writes what is queued and closes the file.
+func (s *FilePushSink) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.queue)
+	s.mu.Unlock()
+
+	s.wg.Wait()
+
+	return s.f.Close()
+}
+
+// PushSinks holds the PushSink the session hands the notifications of the clients offline to.
+type PushSinks struct {
+	mu   sync.RWMutex
+	sink PushSink
+}
+
+func NewPushSinks(c config.PushConfig) *PushSinks {
+	s := new(PushSinks)
+	if c.File != "" {
+		sink, err := NewFilePushSink(c)
+		if err != nil {
+			logx.Errorf("push - open %s error: %v", c.File, err)
+		} else {
+			s.sink = sink
+		}
+	}
+
+	return s
+}
+
+// SetPushSink replaces the sink, e.g. with an APNs or FCM adapter.
+func (s *PushSinks) SetPushSink(sink PushSink) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sink = sink
+}
+
+// PushOffline hands n to the sink, if there is one.
+func (s *PushSinks) PushOffline(ctx context.Context, n *PushNotification) {
+	s.mu.RLock()
+	sink := s.sink
+	s.mu.RUnlock()
+
+	if sink == nil {
+		logx.WithContext(ctx).Debugf("push - no sink, drop the notification of perm_auth_key_id(%d)", n.PermAuthKeyId)
+		return
+	}
+	if err := sink.Push(ctx, n); err != nil {
+		logx.WithContext(ctx).Errorf("push - perm_auth_key_id(%d) error: %v", n.PermAuthKeyId, err)
+	}
+}
+
+// ClosePushSink closes the sink if it needs to, e.g. to flush its queue.
+func (s *PushSinks) ClosePushSink() {
+	s.mu.Lock()
+	sink := s.sink
+	s.sink = nil
+	s.mu.Unlock()
+
+	if closer, ok := sink.(interface{ Close() error }); ok {
+		if err := closer.Close(); err != nil {
+			logx.Errorf("push - close sink error: %v", err)
+		}
+	}
+}
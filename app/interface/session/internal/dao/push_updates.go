@@ -0,0 +1,148 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"context"
+	"sync"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/teamgram-server/v2/app/interface/gnetway/gateway"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// SessionDelivery is what became of a push to one session.
+type SessionDelivery struct {
+	AuthKeyId int64
+	SessionId int64
+	ServerId  string
+	// Delivered is set when the gnetway wrote the updates to a connection of the session
+	Delivered bool
+	// Receipts is the outcome on every connection the gnetway tried, the best first
+	Receipts []*gateway.DeliveryReceipt
+	Err      error
+}
+
+// UpdatesEncoder serializes the updates at the layer of a session.
+type UpdatesEncoder func(layer int32) ([]byte, error)
+
+// PushUpdates sends updates to every live session of permAuthKeyId, but the ones
+// that used invokeWithoutUpdates, with one batch per gnetway, and reports the delivery
+// per session.
+func (d *Dao) PushUpdates(ctx context.Context, permAuthKeyId int64, encode UpdatesEncoder) []SessionDelivery {
+	var (
+		sessions   = d.GetLiveSessions(permAuthKeyId)
+		mu         sync.Mutex
+		bodies     = make(map[int32][]byte)
+		batches    = make(map[string][]*gateway.GatewayData)
+		deliveries = make([]SessionDelivery, 0, len(sessions))
+		group      = threading.NewRoutineGroup()
+	)
+
+	for _, v := range sessions {
+		if v.WithoutUpdates {
+			continue
+		}
+
+		body, ok := bodies[v.Layer]
+		if !ok {
+			var err error
+			if body, err = encode(v.Layer); err != nil {
+				logx.WithContext(ctx).Errorf("pushUpdates - perm_auth_key_id(%d) encode at layer %d error: %v", permAuthKeyId, v.Layer, err)
+			}
+			bodies[v.Layer] = body
+		}
+		if body == nil {
+			continue
+		}
+
+		msgId, seqNo, ok := d.NextMessage(v.PermAuthKeyId, v.AuthKeyId, v.SessionId)
+		if !ok {
+			// closed meanwhile
+			continue
+		}
+
+		x := mtproto.NewEncodeBuf(32 + len(body))
+		x.Long(v.Salt)
+		x.Long(v.SessionId)
+		x.Long(msgId)
+		x.Int(seqNo)
+		x.Int(int32(len(body)))
+		x.Bytes(body)
+
+		batches[v.ServerId] = append(batches[v.ServerId], gateway.MakeTLGatewayData(&gateway.GatewayData{
+			Constructor: gateway.CRC32_gatewayData,
+			AuthKeyId:   v.AuthKeyId,
+			SessionId:   v.SessionId,
+			Payload:     x.GetBuf(),
+		}).To_GatewayData())
+	}
+
+	for serverId, items := range batches {
+		serverId, items := serverId, items
+		group.RunSafe(func() {
+			results, err := d.SendBatchDataToGateway(ctx, serverId, items, d.deliveryTimeout)
+			if err != nil {
+				logx.WithContext(ctx).Errorf("pushUpdates - gateway(%s) perm_auth_key_id(%d) error: %v", serverId, permAuthKeyId, err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for i, item := range items {
+				var receipts []*gateway.DeliveryReceipt
+				if i < len(results) {
+					receipts = results[i].GetReceipts()
+				}
+				deliveries = append(deliveries, SessionDelivery{
+					AuthKeyId: item.AuthKeyId,
+					SessionId: item.SessionId,
+					ServerId:  serverId,
+					Delivered: gateway.Delivered(receipts),
+					Receipts:  receipts,
+					Err:       err,
+				})
+			}
+		})
+	}
+	group.Wait()
+
+	return deliveries
+}
+
+// SendToSession has the gnetway of the session write body, an answer to the client, e.g.
+// an rpc_result, or a pong that isn't content related. It is false if the session, or its
+// connection on the gnetway, is gone.
+func (d *Dao) SendToSession(ctx context.Context, permAuthKeyId, authKeyId, sessionId int64, body []byte, contentRelated bool) (bool, error) {
+	v, msgId, seqNo, ok := d.NextResponse(permAuthKeyId, authKeyId, sessionId, contentRelated)
+	if !ok {
+		return false, nil
+	}
+
+	x := mtproto.NewEncodeBuf(32 + len(body))
+	x.Long(v.Salt)
+	x.Long(v.SessionId)
+	x.Long(msgId)
+	x.Int(seqNo)
+	x.Int(int32(len(body)))
+	x.Bytes(body)
+
+	return d.SendDataToGateway(ctx, v.ServerId, authKeyId, sessionId, x.GetBuf())
+}
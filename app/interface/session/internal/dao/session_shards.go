@@ -0,0 +1,195 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package dao
+
+import (
+	"errors"
+	"sync"
+
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/discovery"
+
+	"github.com/zeromicro/go-zero/core/hash"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type sessionPeer struct {
+	conn zrpc.Client
+	cli  sessionclient.SessionClient
+}
+
+// SessionShards knows the other session nodes and which one owns a shard key. It builds
+// its ring with sessionclient.NewShardRing like the gnetways, over the same nodes and
+// weights both agree on the owner, unless a gnetway ejected a node it found unhealthy.
+type SessionShards struct {
+	serverId   string
+	weights    sessionclient.ShardWeights
+	mu         sync.RWMutex
+	dispatcher *hash.ConsistentHash
+	nodes      []string
+	peers      map[string]*sessionPeer
+	onChange   func()
+}
+
+// NewSessionShards watches the session nodes found by c, weighted by weights, serverId is
+// the value this node is listed with. Without any discovery every key is local.
+func NewSessionShards(c zrpc.RpcClientConf, weights sessionclient.ShardWeights, serverId string) *SessionShards {
+	shards := &SessionShards{
+		serverId:   serverId,
+		weights:    weights,
+		dispatcher: hash.NewConsistentHash(),
+		peers:      make(map[string]*sessionPeer),
+	}
+	shards.watch(c)
+
+	return shards
+}
+
+func (m *SessionShards) watch(c zrpc.RpcClientConf) {
+	sub, err := discovery.NewSubscriber(c)
+	if errors.Is(err, discovery.ErrNoEndpoints) {
+		return
+	} else if err != nil {
+		logx.Errorf("session shards - subscribe(%v) error: %v", c, err)
+		return
+	}
+
+	update := func() {
+		values := sub.Values()
+		dispatcher := sessionclient.NewShardRing(values, m.weights)
+		peers := make(map[string]*sessionPeer, len(values))
+
+		m.mu.RLock()
+		old := m.peers
+		m.mu.RUnlock()
+
+		for _, v := range values {
+			if v == m.serverId {
+				continue
+			}
+			if peer, ok := old[v]; ok {
+				peers[v] = peer
+				continue
+			}
+
+			cli, err2 := zrpc.NewClient(zrpc.RpcClientConf{
+				Endpoints: []string{v},
+				NonBlock:  true,
+			})
+			if err2 != nil {
+				logx.Errorf("session shards - dial session(%s) error: %v", v, err2)
+				continue
+			}
+			peers[v] = &sessionPeer{
+				conn: cli,
+				cli:  sessionclient.NewSessionClient(cli),
+			}
+		}
+
+		m.mu.Lock()
+		m.dispatcher = dispatcher
+		m.nodes = values
+		m.peers = peers
+		onChange := m.onChange
+		m.mu.Unlock()
+
+		if onChange != nil {
+			onChange()
+		}
+	}
+
+	sub.AddListener(update)
+	update()
+}
+
+// SetChangeHook sets what is called after the session nodes changed.
+func (m *SessionShards) SetChangeHook(onChange func()) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.onChange = onChange
+}
+
+// Owner returns the client of the session node owning key, local is true if it is us.
+func (m *SessionShards) Owner(key string) (cli sessionclient.SessionClient, local bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	node, ok := m.dispatcher.Get(key)
+	if !ok || node.(string) == m.serverId {
+		return nil, true
+	}
+
+	peer, ok := m.peers[node.(string)]
+	if !ok {
+		// not dialed, do what we can here
+		return nil, true
+	}
+
+	return peer.cli, false
+}
+
+// OwnerAddr returns the session node owning key, local is true if it is us.
+func (m *SessionShards) OwnerAddr(key string) (addr string, local bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	node, ok := m.dispatcher.Get(key)
+	if !ok || node.(string) == m.serverId {
+		return "", true
+	}
+
+	return node.(string), false
+}
+
+// SuccessorFunc returns who owns a key once we left the ring, ok is false if nobody.
+func (m *SessionShards) SuccessorFunc() func(key string) (addr string, ok bool) {
+	m.mu.RLock()
+	nodes := make([]string, 0, len(m.nodes))
+	for _, node := range m.nodes {
+		if node != m.serverId {
+			nodes = append(nodes, node)
+		}
+	}
+	m.mu.RUnlock()
+
+	dispatcher := sessionclient.NewShardRing(nodes, m.weights)
+
+	return func(key string) (string, bool) {
+		node, ok := dispatcher.Get(key)
+		if !ok {
+			return "", false
+		}
+		return node.(string), true
+	}
+}
+
+// Peer returns the clients of the session node addr.
+func (m *SessionShards) Peer(addr string) (conn zrpc.Client, cli sessionclient.SessionClient, ok bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	peer, ok := m.peers[addr]
+	if !ok {
+		return nil, nil, false
+	}
+
+	return peer.conn, peer.cli, true
+}
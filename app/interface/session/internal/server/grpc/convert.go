@@ -0,0 +1,83 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package grpc
+
+import (
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/v2/bin"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+)
+
+func toSessionClientEvent(v *session2.SessionClientEvent) *session.SessionClientEvent {
+	return (&session.TLSessionClientEvent{
+		ClazzID:       session.ClazzID_sessionClientEvent,
+		ServerId:      v.GetServerId(),
+		ConnType:      v.GetConnType(),
+		AuthKeyId:     v.GetAuthKeyId(),
+		KeyType:       v.GetKeyType(),
+		PermAuthKeyId: v.GetPermAuthKeyId(),
+		SessionId:     v.GetSessionId(),
+		ClientIp:      v.GetClientIp(),
+	}).ToSessionClientEvent()
+}
+
+func toSessionClientData(v *session2.SessionClientData) *session.SessionClientData {
+	return (&session.TLSessionClientData{
+		ClazzID:       session.ClazzID_sessionClientData,
+		ServerId:      v.GetServerId(),
+		ConnType:      v.GetConnType(),
+		AuthKeyId:     v.GetAuthKeyId(),
+		KeyType:       v.GetKeyType(),
+		PermAuthKeyId: v.GetPermAuthKeyId(),
+		SessionId:     v.GetSessionId(),
+		ClientIp:      v.GetClientIp(),
+		QuickAck:      v.GetQuickAck(),
+		Salt:          v.GetSalt(),
+		Payload:       v.GetPayload(),
+	}).ToSessionClientData()
+}
+
+// toTL moves a mtproto object to its kitex binding through the TL encoding both share.
+func toTL(src interface {
+	Encode(x *mtproto.EncodeBuf, layer int32) error
+}, dst interface {
+	Decode(d *bin.Decoder) error
+}) error {
+	x := mtproto.NewEncodeBuf(512)
+	if err := src.Encode(x, 0); err != nil {
+		return err
+	}
+
+	return dst.Decode(bin.NewDecoder(x.GetBuf()))
+}
+
+// fromTL is toTL the other way round.
+func fromTL(src interface {
+	Encode(x *bin.Encoder, layer int32) error
+}, dst interface {
+	Decode(dBuf *mtproto.DecodeBuf) error
+}) error {
+	x := bin.NewEncoder()
+	if err := src.Encode(x, 0); err != nil {
+		return err
+	}
+
+	return dst.Decode(mtproto.NewDecodeBuf(x.Bytes()))
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package grpc
+
+import (
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+	"google.golang.org/grpc"
+)
+
+func init() {
+	zrpc.DontLogContentForMethod("/session.RPCSession/session_sendDataToSession")
+}
+
+// New new a grpc server, it serves the session handlers to the gnetways, their streams
+// and the handoffs of the other session nodes.
+func New(svcCtx *svc.ServiceContext, c zrpc.RpcServerConf) *zrpc.RpcServer {
+	s, err := zrpc.NewServer(c, func(grpcServer *grpc.Server) {
+		session.RegisterRPCSessionServer(grpcServer, NewSessionServiceServer(svcCtx))
+		session.RegisterRPCSessionStreamServer(grpcServer, NewSessionStreamServer(svcCtx))
+		session.RegisterRPCSessionHandoffServer(grpcServer, NewSessionHandoffServer(svcCtx))
+	})
+	logx.Must(err)
+
+	return s
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package grpc
+
+import (
+	"io"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SessionHandoffServer struct {
+	session2.UnimplementedRPCSessionHandoffServer
+	svcCtx *svc.ServiceContext
+}
+
+func NewSessionHandoffServer(svcCtx *svc.ServiceContext) *SessionHandoffServer {
+	return &SessionHandoffServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// SessionHandoff imports the auth keys another session node hands off to us.
+func (s *SessionHandoffServer) SessionHandoff(stream session2.RPCSessionHandoff_SessionHandoffServer) error {
+	var (
+		imported int
+		from     string
+	)
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			logx.Infof("handoff - %d auth keys from session(%s)", imported, from)
+			return stream.SendAndClose(&session2.SessionHandoffResult{
+				Imported: int32(imported),
+			})
+		}
+		if err != nil {
+			logx.Errorf("handoff - from session(%s) error: %v, imported: %d", from, err, imported)
+			return err
+		}
+
+		from = chunk.GetFromServerId()
+		imported += s.svcCtx.Import(chunk)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/teamgram/proto/mtproto"
+	"github.com/teamgram/proto/v2/mt"
+	"github.com/teamgram/proto/v2/tg"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/core"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+)
+
+// SessionServiceServer serves the session handlers to the session2 clients, it converts
+// the requests to the kitex binding the handlers use and the replies back. A gnetway
+// hosted in the same process calls it directly.
+type SessionServiceServer struct {
+	session2.UnimplementedRPCSessionServer
+	svcCtx *svc.ServiceContext
+}
+
+func NewSessionServiceServer(svcCtx *svc.ServiceContext) *SessionServiceServer {
+	return &SessionServiceServer{
+		svcCtx: svcCtx,
+	}
+}
+
+func toBool(r *tg.Bool, err error) (*mtproto.Bool, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	reply := new(mtproto.Bool)
+	if err = fromTL(r, reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+func toUpdates(v *mtproto.Updates) (*tg.Updates, error) {
+	if v == nil {
+		return nil, nil
+	}
+
+	updates := new(tg.Updates)
+	if err := toTL(v, updates); err != nil {
+		return nil, err
+	}
+
+	return updates, nil
+}
+
+// SessionQueryAuthKey
+// session.queryAuthKey auth_key_id:long = AuthKeyInfo;
+func (s *SessionServiceServer) SessionQueryAuthKey(ctx context.Context, in *session2.TLSessionQueryAuthKey) (*mtproto.AuthKeyInfo, error) {
+	r, err := core.New(ctx, s.svcCtx).SessionQueryAuthKey(&session.TLSessionQueryAuthKey{
+		ClazzID:   session.ClazzID_session_queryAuthKey,
+		AuthKeyId: in.GetAuthKeyId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := new(mtproto.AuthKeyInfo)
+	if err = fromTL(r, reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// SessionSetAuthKey
+// session.setAuthKey auth_key:AuthKeyInfo future_salt:FutureSalt expires_in:int = Bool;
+func (s *SessionServiceServer) SessionSetAuthKey(ctx context.Context, in *session2.TLSessionSetAuthKey) (*mtproto.Bool, error) {
+	var (
+		authKey    *tg.AuthKeyInfo
+		futureSalt *mt.FutureSalt
+	)
+
+	if in.GetAuthKey() != nil {
+		authKey = new(tg.AuthKeyInfo)
+		if err := toTL(in.GetAuthKey(), authKey); err != nil {
+			return nil, err
+		}
+	}
+	if in.GetFutureSalt() != nil {
+		futureSalt = new(mt.FutureSalt)
+		if err := toTL(in.GetFutureSalt(), futureSalt); err != nil {
+			return nil, err
+		}
+	}
+
+	return toBool(core.New(ctx, s.svcCtx).SessionSetAuthKey(&session.TLSessionSetAuthKey{
+		ClazzID:    session.ClazzID_session_setAuthKey,
+		AuthKey:    authKey,
+		FutureSalt: futureSalt,
+		ExpiresIn:  in.GetExpiresIn(),
+	}))
+}
+
+// SessionCreateSession
+// session.createSession client:SessionClientEvent = Bool;
+func (s *SessionServiceServer) SessionCreateSession(ctx context.Context, in *session2.TLSessionCreateSession) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionCreateSession(&session.TLSessionCreateSession{
+		ClazzID: session.ClazzID_session_createSession,
+		Client:  toSessionClientEvent(in.GetClient()),
+	}))
+}
+
+// SessionSendDataToSession
+// session.sendDataToSession data:SessionClientData = Bool;
+func (s *SessionServiceServer) SessionSendDataToSession(ctx context.Context, in *session2.TLSessionSendDataToSession) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionSendDataToSession(&session.TLSessionSendDataToSession{
+		ClazzID: session.ClazzID_session_sendDataToSession,
+		Data:    toSessionClientData(in.GetData()),
+	}))
+}
+
+// SessionSendHttpDataToSession
+// session.sendHttpDataToSession client:SessionClientData = HttpSessionData;
+func (s *SessionServiceServer) SessionSendHttpDataToSession(ctx context.Context, in *session2.TLSessionSendHttpDataToSession) (*session2.HttpSessionData, error) {
+	r, err := core.New(ctx, s.svcCtx).SessionSendHttpDataToSession(&session.TLSessionSendHttpDataToSession{
+		ClazzID: session.ClazzID_session_sendHttpDataToSession,
+		Client:  toSessionClientData(in.GetClient()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &session2.HttpSessionData{}
+	if v, ok := r.ToHttpSessionData(); ok {
+		reply.Payload = v.Payload
+	}
+
+	return session2.MakeTLHttpSessionData(reply).To_HttpSessionData(), nil
+}
+
+// SessionCloseSession
+// session.closeSession client:SessionClientEvent = Bool;
+func (s *SessionServiceServer) SessionCloseSession(ctx context.Context, in *session2.TLSessionCloseSession) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionCloseSession(&session.TLSessionCloseSession{
+		ClazzID: session.ClazzID_session_closeSession,
+		Client:  toSessionClientEvent(in.GetClient()),
+	}))
+}
+
+// SessionPushUpdatesData
+// session.pushUpdatesData flags:# perm_auth_key_id:long notification:flags.0?true updates:Updates = Bool;
+func (s *SessionServiceServer) SessionPushUpdatesData(ctx context.Context, in *session2.TLSessionPushUpdatesData) (*mtproto.Bool, error) {
+	updates, err := toUpdates(in.GetUpdates())
+	if err != nil {
+		return nil, err
+	}
+
+	return toBool(core.New(ctx, s.svcCtx).SessionPushUpdatesData(&session.TLSessionPushUpdatesData{
+		ClazzID:       session.ClazzID_session_pushUpdatesData,
+		PermAuthKeyId: in.GetPermAuthKeyId(),
+		Notification:  in.GetNotification(),
+		Updates:       updates,
+	}))
+}
+
+// SessionPushSessionUpdatesData
+// session.pushSessionUpdatesData flags:# perm_auth_key_id:long auth_key_id:long session_id:long updates:Updates = Bool;
+func (s *SessionServiceServer) SessionPushSessionUpdatesData(ctx context.Context, in *session2.TLSessionPushSessionUpdatesData) (*mtproto.Bool, error) {
+	updates, err := toUpdates(in.GetUpdates())
+	if err != nil {
+		return nil, err
+	}
+
+	return toBool(core.New(ctx, s.svcCtx).SessionPushSessionUpdatesData(&session.TLSessionPushSessionUpdatesData{
+		ClazzID:       session.ClazzID_session_pushSessionUpdatesData,
+		PermAuthKeyId: in.GetPermAuthKeyId(),
+		AuthKeyId:     in.GetAuthKeyId(),
+		SessionId:     in.GetSessionId(),
+		Updates:       updates,
+	}))
+}
+
+// SessionPushRpcResultData
+// session.pushRpcResultData perm_auth_key_id:long auth_key_id:long session_id:long client_req_msg_id:long rpc_result_data:bytes = Bool;
+func (s *SessionServiceServer) SessionPushRpcResultData(ctx context.Context, in *session2.TLSessionPushRpcResultData) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionPushRpcResultData(&session.TLSessionPushRpcResultData{
+		ClazzID:        session.ClazzID_session_pushRpcResultData,
+		PermAuthKeyId:  in.GetPermAuthKeyId(),
+		AuthKeyId:      in.GetAuthKeyId(),
+		SessionId:      in.GetSessionId(),
+		ClientReqMsgId: in.GetClientReqMsgId(),
+		RpcResultData:  in.GetRpcResultData(),
+	}))
+}
+
+// SessionInvalidateAuthKey
+// session.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (s *SessionServiceServer) SessionInvalidateAuthKey(ctx context.Context, in *session2.TLSessionInvalidateAuthKey) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionInvalidateAuthKey(&session.TLSessionInvalidateAuthKey{
+		ClazzID:   session.ClazzID_session_invalidateAuthKey,
+		AuthKeyId: in.GetAuthKeyId(),
+		Destroyed: in.GetDestroyed(),
+	}))
+}
+
+// SessionBindTempAuthKey
+// session.bindTempAuthKey perm_auth_key_id:long temp_auth_key_id:long expires_at:long = Bool;
+func (s *SessionServiceServer) SessionBindTempAuthKey(ctx context.Context, in *session2.TLSessionBindTempAuthKey) (*mtproto.Bool, error) {
+	return toBool(core.New(ctx, s.svcCtx).SessionBindTempAuthKey(&session.TLSessionBindTempAuthKey{
+		ClazzID:       session.ClazzID_session_bindTempAuthKey,
+		PermAuthKeyId: in.GetPermAuthKeyId(),
+		TempAuthKeyId: in.GetTempAuthKeyId(),
+		ExpiresAt:     in.GetExpiresAt(),
+	}))
+}
+
+// SessionQueryAuthKeyInfo
+// session.queryAuthKeyInfo auth_key_id:long = SessionAuthKeyInfo;
+func (s *SessionServiceServer) SessionQueryAuthKeyInfo(ctx context.Context, in *session2.TLSessionQueryAuthKeyInfo) (*session2.SessionAuthKeyInfo, error) {
+	r, err := core.New(ctx, s.svcCtx).SessionQueryAuthKeyInfo(&session.TLSessionQueryAuthKeyInfo{
+		ClazzID:   session.ClazzID_session_queryAuthKeyInfo,
+		AuthKeyId: in.GetAuthKeyId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reply := new(session2.SessionAuthKeyInfo)
+	if err = fromTL(r, reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
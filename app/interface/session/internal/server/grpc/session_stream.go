@@ -0,0 +1,96 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/core"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session"
+	session2 "github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/streamlink"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SessionStreamServer struct {
+	session2.UnimplementedRPCSessionStreamServer
+	svcCtx *svc.ServiceContext
+}
+
+func NewSessionStreamServer(svcCtx *svc.ServiceContext) *SessionStreamServer {
+	return &SessionStreamServer{
+		svcCtx: svcCtx,
+	}
+}
+
+// SessionStream serves the stream a gnetway opened, its first frame carries the server_id
+// of the gnetway, which picks the link the stream belongs to.
+func (s *SessionStreamServer) SessionStream(stream session2.RPCSessionStream_SessionStreamServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	serverId := first.GetServerId()
+	logx.Infof("session stream - gateway(%s) connected", serverId)
+
+	link := s.svcCtx.GetOrCreateLink(serverId, s.svcCtx.Config.Stream, func(item *session2.SessionStreamItem) {
+		s.onItem(serverId, item)
+	})
+	err = link.Serve(stream, first)
+	logx.Infof("session stream - gateway(%s) disconnected: %v", serverId, err)
+
+	return err
+}
+
+// onItem handles an item of the gnetway serverId like the kitex rpc would, in the order
+// the gnetway sent them.
+func (s *SessionStreamServer) onItem(serverId string, item *session2.SessionStreamItem) {
+	var (
+		c   = core.New(context.Background(), s.svcCtx)
+		err error
+	)
+
+	switch v := item.Item.(type) {
+	case *session2.SessionStreamItem_CreateSession:
+		_, err = c.SessionCreateSession(&session.TLSessionCreateSession{
+			ClazzID: session.ClazzID_session_createSession,
+			Client:  toSessionClientEvent(v.CreateSession),
+		})
+	case *session2.SessionStreamItem_SendData:
+		_, err = c.SessionSendDataToSession(&session.TLSessionSendDataToSession{
+			ClazzID: session.ClazzID_session_sendDataToSession,
+			Data:    toSessionClientData(v.SendData),
+		})
+	case *session2.SessionStreamItem_CloseSession:
+		_, err = c.SessionCloseSession(&session.TLSessionCloseSession{
+			ClazzID: session.ClazzID_session_closeSession,
+			Client:  toSessionClientEvent(v.CloseSession),
+		})
+	default:
+		logx.Errorf("session stream - gateway(%s) sent an unexpected item: %v", serverId, item)
+		return
+	}
+
+	if err != nil {
+		logx.Errorf("session stream - gateway(%s) item: %v, error: %v", serverId, item, err)
+	}
+}
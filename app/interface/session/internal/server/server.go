@@ -19,38 +19,108 @@
 package server
 
 import (
-	"flag"
+	"context"
+	"net"
+	"time"
 
 	"github.com/teamgram/proto/v2/rpc/codec"
+	gateway_client "github.com/teamgram/teamgram-server/v2/app/interface/gnetway/client"
+	sessionclient "github.com/teamgram/teamgram-server/v2/app/interface/session/client2"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/core"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/server/grpc"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/server/tg/service"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/svc"
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/session/sessionservice"
+	"github.com/teamgram/teamgram-server/v2/pkg/conf2"
 
 	"github.com/cloudwego/kitex/server"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
 )
 
-var configFile = flag.String("f", "etc/session.yaml", "the config file")
-
 type Server struct {
 	server.Server
+	grpcSrv *zrpc.RpcServer
+	svcCtx  *svc.ServiceContext
+
+	configFile *string
+	// c is set when hosted in the same process as the gnetway, there is nothing to listen on then
+	c *config.Config
 }
 
-func New() *Server {
-	return new(Server)
+// New creates the session server, configFile is read by Initialize, after the flags are parsed.
+func New(configFile *string) *Server {
+	return &Server{
+		configFile: configFile,
+	}
+}
+
+// NewInProcess creates a session server for the process of a gnetway, it serves nothing
+// over the network, the gnetway calls SessionClient and registers itself with SetGatewayClient.
+func NewInProcess(c config.Config) *Server {
+	return &Server{
+		c: &c,
+	}
 }
 
 func (s *Server) Initialize() error {
 	var c config.Config
+	if s.c != nil {
+		c = *s.c
+	} else {
+		conf2.MustLoad(*s.configFile, &c)
+	}
+
+	logx.Infov(c)
+
 	ctx := svc.NewServiceContext(c)
-	_ = ctx
+	s.svcCtx = ctx
+	ctx.SetSessionStateProvider(ctx.LiveSessions)
+	core.SetupInvoker(ctx)
+
+	if s.c != nil {
+		return nil
+	}
+
+	if !c.Kitex.Disable {
+		opts := []server.Option{server.WithCodec(codec.NewZRpcCodec(true))}
+		if c.Kitex.ListenOn != "" {
+			addr, err := net.ResolveTCPAddr("tcp", c.Kitex.ListenOn)
+			if err != nil {
+				return err
+			}
+			opts = append(opts, server.WithServiceAddr(addr))
+		}
+		s.Server = sessionservice.NewServer(service.New(ctx), opts...)
+	}
+
+	// the session handlers, the streams of the gnetways and the handoffs of the other session nodes
+	if c.ListenOn != "" {
+		s.grpcSrv = grpc.New(ctx, c.RpcServerConf)
+		go func() {
+			s.grpcSrv.Start()
+		}()
+	}
 
-	cCodec := codec.NewZRpcCodec(true)
-	s.Server = sessionservice.NewServer(service.New(ctx), server.WithCodec(cCodec))
 	return nil
 }
 
+// SessionClient calls the session handlers directly, without going over the network.
+func (s *Server) SessionClient() sessionclient.SessionClient {
+	return grpc.NewSessionServiceServer(s.svcCtx)
+}
+
+// SetGatewayClient makes the calls to the gnetway gatewayId go to cli.
+func (s *Server) SetGatewayClient(gatewayId string, cli gateway_client.GatewayClient) {
+	s.svcCtx.SetGatewayClient(gatewayId, cli)
+}
+
 func (s *Server) RunLoop() {
+	if s.Server == nil {
+		return
+	}
+
 	if err := s.Server.Run(); err != nil {
 		// log.Println("server stopped with error:", err)
 	} else {
@@ -59,4 +129,20 @@ func (s *Server) RunLoop() {
 }
 
 func (s *Server) Destroy() {
+	// hand our auth keys off while we still forward what reaches us
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	if err := s.svcCtx.Drain(ctx); err != nil {
+		logx.Errorf("drain error: %v", err)
+	}
+	cancel()
+
+	if s.grpcSrv != nil {
+		s.grpcSrv.Stop()
+	}
+	if s.Server != nil {
+		if err := s.Server.Stop(); err != nil {
+			logx.Errorf("stop kitex server error: %v", err)
+		}
+	}
+	s.svcCtx.ClosePushSink()
 }
@@ -154,3 +154,48 @@ func (s *Service) SessionPushRpcResultData(ctx context.Context, request *session
 	klog.Infof("echos.echo - reply: %s", r)
 	return r, err
 }
+
+// SessionInvalidateAuthKey
+// session.invalidateAuthKey flags:# auth_key_id:long destroyed:flags.0?true = Bool;
+func (s *Service) SessionInvalidateAuthKey(ctx context.Context, request *session.TLSessionInvalidateAuthKey) (*tg.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	klog.Infof("session.invalidateAuthKey - metadata: {}, request: %v", request)
+
+	r, err := c.SessionInvalidateAuthKey(request)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Infof("session.invalidateAuthKey - reply: %s", r)
+	return r, err
+}
+
+// SessionBindTempAuthKey
+// session.bindTempAuthKey perm_auth_key_id:long temp_auth_key_id:long expires_at:long = Bool;
+func (s *Service) SessionBindTempAuthKey(ctx context.Context, request *session.TLSessionBindTempAuthKey) (*tg.Bool, error) {
+	c := core.New(ctx, s.svcCtx)
+	klog.Infof("session.bindTempAuthKey - metadata: {}, request: %v", request)
+
+	r, err := c.SessionBindTempAuthKey(request)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Infof("session.bindTempAuthKey - reply: %s", r)
+	return r, err
+}
+
+// SessionQueryAuthKeyInfo
+// session.queryAuthKeyInfo auth_key_id:long = SessionAuthKeyInfo;
+func (s *Service) SessionQueryAuthKeyInfo(ctx context.Context, request *session.TLSessionQueryAuthKeyInfo) (*session.SessionAuthKeyInfo, error) {
+	c := core.New(ctx, s.svcCtx)
+	klog.Infof("session.queryAuthKeyInfo - metadata: {}, request: %v", request)
+
+	r, err := c.SessionQueryAuthKeyInfo(request)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.Infof("session.queryAuthKeyInfo - reply: %s", r)
+	return r, err
+}
@@ -19,15 +19,56 @@
 package svc
 
 import (
+	"os"
+	"strings"
+
 	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/config"
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/internal/dao"
+
+	"github.com/zeromicro/go-zero/core/netx"
+)
+
+const (
+	allEths  = "0.0.0.0"
+	envPodIp = "POD_IP"
 )
 
+// figureOutListenOn returns the address we register in etcd, the same one zrpc uses.
+func figureOutListenOn(listenOn string) string {
+	fields := strings.Split(listenOn, ":")
+	if len(fields) == 0 {
+		return listenOn
+	}
+
+	host := fields[0]
+	if len(host) > 0 && host != allEths {
+		return listenOn
+	}
+
+	ip := os.Getenv(envPodIp)
+	if len(ip) == 0 {
+		ip = netx.InternalIp()
+	}
+	if len(ip) == 0 {
+		return listenOn
+	}
+
+	return strings.Join(append([]string{ip}, fields[1:]...), ":")
+}
+
 type ServiceContext struct {
 	Config config.Config
+	*dao.Dao
+
+	ServerId string
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	serverId := figureOutListenOn(c.ListenOn)
+
 	return &ServiceContext{
-		Config: c,
+		Config:   c,
+		Dao:      dao.New(c, serverId),
+		ServerId: serverId,
 	}
 }
@@ -14,6 +14,7 @@ const (
 	ClazzID_sessionClientEvent             = 0xf17f375f // f17f375f
 	ClazzID_sessionClientData              = 0x41a20c4e // 41a20c4e
 	ClazzID_httpSessionData                = 0xdbd8534f // dbd8534f
+	ClazzID_sessionAuthKeyInfo             = 0x12f4febf // 12f4febf
 	ClazzID_session_queryAuthKey           = 0x6b2df851 // 6b2df851
 	ClazzID_session_setAuthKey             = 0x1d11490b // 1d11490b
 	ClazzID_session_createSession          = 0x410cb20d // 410cb20d
@@ -23,4 +24,7 @@ const (
 	ClazzID_session_pushUpdatesData        = 0xa574d829 // a574d829
 	ClazzID_session_pushSessionUpdatesData = 0x45f3fda0 // 45f3fda0
 	ClazzID_session_pushRpcResultData      = 0x4b470c89 // 4b470c89
+	ClazzID_session_invalidateAuthKey      = 0xccb679ef // ccb679ef
+	ClazzID_session_bindTempAuthKey        = 0xa092276c // a092276c
+	ClazzID_session_queryAuthKeyInfo       = 0x29d04ef1 // 29d04ef1
 )
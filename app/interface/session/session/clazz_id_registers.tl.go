@@ -20,6 +20,7 @@ import (
 func init() {
 	// Constructor
 	iface.RegisterClazzID(0xdbd8534f, func() iface.TLObject { return &TLHttpSessionData{ClazzID: 0xdbd8534f} })    // 0xdbd8534f
+	iface.RegisterClazzID(0x12f4febf, func() iface.TLObject { return &TLSessionAuthKeyInfo{ClazzID: 0x12f4febf} }) // 0x12f4febf
 	iface.RegisterClazzID(0x41a20c4e, func() iface.TLObject { return &TLSessionClientData{ClazzID: 0x41a20c4e} })  // 0x41a20c4e
 	iface.RegisterClazzID(0xf17f375f, func() iface.TLObject { return &TLSessionClientEvent{ClazzID: 0xf17f375f} }) // 0xf17f375f
 
@@ -33,4 +34,7 @@ func init() {
 	iface.RegisterClazzID(0xa574d829, func() iface.TLObject { return &TLSessionPushUpdatesData{ClazzID: 0xa574d829} })        // 0xa574d829
 	iface.RegisterClazzID(0x45f3fda0, func() iface.TLObject { return &TLSessionPushSessionUpdatesData{ClazzID: 0x45f3fda0} }) // 0x45f3fda0
 	iface.RegisterClazzID(0x4b470c89, func() iface.TLObject { return &TLSessionPushRpcResultData{ClazzID: 0x4b470c89} })      // 0x4b470c89
+	iface.RegisterClazzID(0xccb679ef, func() iface.TLObject { return &TLSessionInvalidateAuthKey{ClazzID: 0xccb679ef} })      // 0xccb679ef
+	iface.RegisterClazzID(0xa092276c, func() iface.TLObject { return &TLSessionBindTempAuthKey{ClazzID: 0xa092276c} })        // 0xa092276c
+	iface.RegisterClazzID(0x29d04ef1, func() iface.TLObject { return &TLSessionQueryAuthKeyInfo{ClazzID: 0x29d04ef1} })       // 0x29d04ef1
 }
@@ -18,6 +18,7 @@ const (
 	ClazzName_sessionClientEvent             = "sessionClientEvent"
 	ClazzName_sessionClientData              = "sessionClientData"
 	ClazzName_httpSessionData                = "httpSessionData"
+	ClazzName_sessionAuthKeyInfo             = "sessionAuthKeyInfo"
 	ClazzName_session_queryAuthKey           = "session_queryAuthKey"
 	ClazzName_session_setAuthKey             = "session_setAuthKey"
 	ClazzName_session_createSession          = "session_createSession"
@@ -27,6 +28,9 @@ const (
 	ClazzName_session_pushUpdatesData        = "session_pushUpdatesData"
 	ClazzName_session_pushSessionUpdatesData = "session_pushSessionUpdatesData"
 	ClazzName_session_pushRpcResultData      = "session_pushRpcResultData"
+	ClazzName_session_invalidateAuthKey      = "session_invalidateAuthKey"
+	ClazzName_session_bindTempAuthKey        = "session_bindTempAuthKey"
+	ClazzName_session_queryAuthKeyInfo       = "session_queryAuthKeyInfo"
 )
 
 func init() {
@@ -34,6 +38,7 @@ func init() {
 	iface.RegisterClazzName(ClazzName_sessionClientEvent, 0, 0xf17f375f)             // f17f375f
 	iface.RegisterClazzName(ClazzName_sessionClientData, 0, 0x41a20c4e)              // 41a20c4e
 	iface.RegisterClazzName(ClazzName_httpSessionData, 0, 0xdbd8534f)                // dbd8534f
+	iface.RegisterClazzName(ClazzName_sessionAuthKeyInfo, 0, 0x12f4febf)             // 12f4febf
 	iface.RegisterClazzName(ClazzName_session_queryAuthKey, 0, 0x6b2df851)           // 6b2df851
 	iface.RegisterClazzName(ClazzName_session_setAuthKey, 0, 0x1d11490b)             // 1d11490b
 	iface.RegisterClazzName(ClazzName_session_createSession, 0, 0x410cb20d)          // 410cb20d
@@ -43,11 +48,15 @@ func init() {
 	iface.RegisterClazzName(ClazzName_session_pushUpdatesData, 0, 0xa574d829)        // a574d829
 	iface.RegisterClazzName(ClazzName_session_pushSessionUpdatesData, 0, 0x45f3fda0) // 45f3fda0
 	iface.RegisterClazzName(ClazzName_session_pushRpcResultData, 0, 0x4b470c89)      // 4b470c89
+	iface.RegisterClazzName(ClazzName_session_invalidateAuthKey, 0, 0xccb679ef)      // ccb679ef
+	iface.RegisterClazzName(ClazzName_session_bindTempAuthKey, 0, 0xa092276c)        // a092276c
+	iface.RegisterClazzName(ClazzName_session_queryAuthKeyInfo, 0, 0x29d04ef1)       // 29d04ef1
 
 	//RegisterClazzIDNameList
 	iface.RegisterClazzIDName(ClazzName_sessionClientEvent, 0xf17f375f)             // f17f375f
 	iface.RegisterClazzIDName(ClazzName_sessionClientData, 0x41a20c4e)              // 41a20c4e
 	iface.RegisterClazzIDName(ClazzName_httpSessionData, 0xdbd8534f)                // dbd8534f
+	iface.RegisterClazzIDName(ClazzName_sessionAuthKeyInfo, 0x12f4febf)             // 12f4febf
 	iface.RegisterClazzIDName(ClazzName_session_queryAuthKey, 0x6b2df851)           // 6b2df851
 	iface.RegisterClazzIDName(ClazzName_session_setAuthKey, 0x1d11490b)             // 1d11490b
 	iface.RegisterClazzIDName(ClazzName_session_createSession, 0x410cb20d)          // 410cb20d
@@ -57,4 +66,7 @@ func init() {
 	iface.RegisterClazzIDName(ClazzName_session_pushUpdatesData, 0xa574d829)        // a574d829
 	iface.RegisterClazzIDName(ClazzName_session_pushSessionUpdatesData, 0x45f3fda0) // 45f3fda0
 	iface.RegisterClazzIDName(ClazzName_session_pushRpcResultData, 0x4b470c89)      // 4b470c89
+	iface.RegisterClazzIDName(ClazzName_session_invalidateAuthKey, 0xccb679ef)      // ccb679ef
+	iface.RegisterClazzIDName(ClazzName_session_bindTempAuthKey, 0xa092276c)        // a092276c
+	iface.RegisterClazzIDName(ClazzName_session_queryAuthKeyInfo, 0x29d04ef1)       // 29d04ef1
 }
@@ -165,6 +165,155 @@ func (m *HttpSessionData) ToHttpSessionData() (*TLHttpSessionData, bool) {
 	return nil, false
 }
 
+// SessionAuthKeyInfoClazz <--
+//   - TL_SessionAuthKeyInfo
+type SessionAuthKeyInfoClazz interface {
+	iface.TLObject
+	SessionAuthKeyInfoClazzName() string
+}
+
+func DecodeSessionAuthKeyInfoClazz(d *bin.Decoder) (SessionAuthKeyInfoClazz, error) {
+	// id, err := d.PeekClazzID()
+	id, err := d.ClazzID()
+	if err != nil {
+		return nil, err
+	}
+
+	clazzName := iface.GetClazzNameByID(id)
+	switch clazzName {
+	case ClazzName_sessionAuthKeyInfo:
+		x := &TLSessionAuthKeyInfo{ClazzID: id}
+		_ = x.Decode(d)
+		return x, nil
+	default:
+		return nil, fmt.Errorf("DecodeSessionAuthKeyInfo - unexpected clazzId: %d", id)
+	}
+}
+
+// TLSessionAuthKeyInfo <--
+type TLSessionAuthKeyInfo struct {
+	ClazzID   uint32          `json:"_id"`
+	AuthKey   *tg.AuthKeyInfo `json:"auth_key"`
+	ExpiresAt int64           `json:"expires_at"`
+}
+
+// SessionAuthKeyInfoClazzName <--
+func (m *TLSessionAuthKeyInfo) SessionAuthKeyInfoClazzName() string {
+	return ClazzName_sessionAuthKeyInfo
+}
+
+// ClazzName <--
+func (m *TLSessionAuthKeyInfo) ClazzName() string {
+	return ClazzName_sessionAuthKeyInfo
+}
+
+// ToSessionAuthKeyInfo <--
+func (m *TLSessionAuthKeyInfo) ToSessionAuthKeyInfo() *SessionAuthKeyInfo {
+	return MakeSessionAuthKeyInfo(m)
+}
+
+// Encode <--
+func (m *TLSessionAuthKeyInfo) Encode(x *bin.Encoder, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0x12f4febf: func() error {
+			x.PutClazzID(0x12f4febf)
+
+			_ = m.AuthKey.Encode(x, layer)
+			x.PutInt64(m.ExpiresAt)
+
+			return nil
+		},
+	}
+
+	clazzId := iface.GetClazzIDByName(ClazzName_sessionAuthKeyInfo, int(layer))
+	if f, ok := encodeF[clazzId]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		return fmt.Errorf("not found clazzId by (%s, %d)", ClazzName_sessionAuthKeyInfo, layer)
+	}
+}
+
+// Decode <--
+func (m *TLSessionAuthKeyInfo) Decode(d *bin.Decoder) (err error) {
+	var decodeF = map[uint32]func() error{
+		0x12f4febf: func() (err error) {
+
+			m1 := &tg.AuthKeyInfo{}
+			_ = m1.Decode(d)
+			m.AuthKey = m1
+
+			m.ExpiresAt, err = d.Int64()
+
+			return nil
+		},
+	}
+
+	if f, ok := decodeF[m.ClazzID]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", m.ClazzID)
+	}
+}
+
+// SessionAuthKeyInfo <--
+type SessionAuthKeyInfo struct {
+	// ClazzID   uint32 `json:"_id"`
+	// ClazzName string `json:"_name"`
+	SessionAuthKeyInfoClazz
+}
+
+// MakeSessionAuthKeyInfo <--
+func MakeSessionAuthKeyInfo(c SessionAuthKeyInfoClazz) *SessionAuthKeyInfo {
+	return &SessionAuthKeyInfo{
+		// ClazzID:   c.ClazzID(),
+		// ClazzName: c.ClazzName(),
+		SessionAuthKeyInfoClazz: c,
+	}
+}
+
+// Encode <--
+func (m *SessionAuthKeyInfo) Encode(x *bin.Encoder, layer int32) error {
+	if m.SessionAuthKeyInfoClazz != nil {
+		return m.SessionAuthKeyInfoClazz.Encode(x, layer)
+	}
+
+	return fmt.Errorf("SessionAuthKeyInfo - invalid Clazz")
+}
+
+// Decode <--
+func (m *SessionAuthKeyInfo) Decode(d *bin.Decoder) (err error) {
+	m.SessionAuthKeyInfoClazz, err = DecodeSessionAuthKeyInfoClazz(d)
+	return
+}
+
+// Match <--
+func (m *SessionAuthKeyInfo) Match(f ...interface{}) {
+	switch c := m.SessionAuthKeyInfoClazz.(type) {
+	case *TLSessionAuthKeyInfo:
+		for _, v := range f {
+			if f1, ok := v.(func(c *TLSessionAuthKeyInfo) interface{}); ok {
+				f1(c)
+			}
+		}
+	default:
+		//
+	}
+}
+
+// ToSessionAuthKeyInfo <--
+func (m *SessionAuthKeyInfo) ToSessionAuthKeyInfo() (*TLSessionAuthKeyInfo, bool) {
+	if m.SessionAuthKeyInfoClazz == nil {
+		return nil, false
+	}
+
+	if x, ok := m.SessionAuthKeyInfoClazz.(*TLSessionAuthKeyInfo); ok {
+		return x, true
+	}
+
+	return nil, false
+}
+
 // SessionClientDataClazz <--
 //   - TL_SessionClientData
 type SessionClientDataClazz interface {
@@ -508,6 +508,164 @@ func (m *TLSessionPushRpcResultData) Decode(d *bin.Decoder) (err error) {
 	}
 }
 
+// TLSessionInvalidateAuthKey <--
+type TLSessionInvalidateAuthKey struct {
+	ClazzID   uint32 `json:"_id"`
+	AuthKeyId int64  `json:"auth_key_id"`
+	Destroyed bool   `json:"destroyed"`
+}
+
+// Encode <--
+func (m *TLSessionInvalidateAuthKey) Encode(x *bin.Encoder, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0xccb679ef: func() error {
+			x.PutClazzID(0xccb679ef)
+
+			// set flags
+			var getFlags = func() uint32 {
+				var flags uint32 = 0
+
+				if m.Destroyed == true {
+					flags |= 1 << 0
+				}
+
+				return flags
+			}
+
+			// set flags
+			var flags = getFlags()
+			x.PutUint32(flags)
+			x.PutInt64(m.AuthKeyId)
+
+			return nil
+		},
+	}
+
+	clazzId := iface.GetClazzIDByName(ClazzName_session_invalidateAuthKey, int(layer))
+	if f, ok := encodeF[clazzId]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		return fmt.Errorf("not found clazzId by (%s, %d)", ClazzName_session_invalidateAuthKey, layer)
+	}
+}
+
+// Decode <--
+func (m *TLSessionInvalidateAuthKey) Decode(d *bin.Decoder) (err error) {
+	var decodeF = map[uint32]func() error{
+		0xccb679ef: func() (err error) {
+			flags, _ := d.Uint32()
+			_ = flags
+			m.AuthKeyId, err = d.Int64()
+			if (flags & (1 << 0)) != 0 {
+				m.Destroyed = true
+			}
+
+			return nil
+		},
+	}
+
+	if f, ok := decodeF[m.ClazzID]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", m.ClazzID)
+	}
+}
+
+// TLSessionBindTempAuthKey <--
+type TLSessionBindTempAuthKey struct {
+	ClazzID       uint32 `json:"_id"`
+	PermAuthKeyId int64  `json:"perm_auth_key_id"`
+	TempAuthKeyId int64  `json:"temp_auth_key_id"`
+	ExpiresAt     int64  `json:"expires_at"`
+}
+
+// Encode <--
+func (m *TLSessionBindTempAuthKey) Encode(x *bin.Encoder, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0xa092276c: func() error {
+			x.PutClazzID(0xa092276c)
+
+			x.PutInt64(m.PermAuthKeyId)
+			x.PutInt64(m.TempAuthKeyId)
+			x.PutInt64(m.ExpiresAt)
+
+			return nil
+		},
+	}
+
+	clazzId := iface.GetClazzIDByName(ClazzName_session_bindTempAuthKey, int(layer))
+	if f, ok := encodeF[clazzId]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		return fmt.Errorf("not found clazzId by (%s, %d)", ClazzName_session_bindTempAuthKey, layer)
+	}
+}
+
+// Decode <--
+func (m *TLSessionBindTempAuthKey) Decode(d *bin.Decoder) (err error) {
+	var decodeF = map[uint32]func() error{
+		0xa092276c: func() (err error) {
+			m.PermAuthKeyId, err = d.Int64()
+			m.TempAuthKeyId, err = d.Int64()
+			m.ExpiresAt, err = d.Int64()
+
+			return nil
+		},
+	}
+
+	if f, ok := decodeF[m.ClazzID]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", m.ClazzID)
+	}
+}
+
+// TLSessionQueryAuthKeyInfo <--
+type TLSessionQueryAuthKeyInfo struct {
+	ClazzID   uint32 `json:"_id"`
+	AuthKeyId int64  `json:"auth_key_id"`
+}
+
+// Encode <--
+func (m *TLSessionQueryAuthKeyInfo) Encode(x *bin.Encoder, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0x29d04ef1: func() error {
+			x.PutClazzID(0x29d04ef1)
+
+			x.PutInt64(m.AuthKeyId)
+
+			return nil
+		},
+	}
+
+	clazzId := iface.GetClazzIDByName(ClazzName_session_queryAuthKeyInfo, int(layer))
+	if f, ok := encodeF[clazzId]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		return fmt.Errorf("not found clazzId by (%s, %d)", ClazzName_session_queryAuthKeyInfo, layer)
+	}
+}
+
+// Decode <--
+func (m *TLSessionQueryAuthKeyInfo) Decode(d *bin.Decoder) (err error) {
+	var decodeF = map[uint32]func() error{
+		0x29d04ef1: func() (err error) {
+			m.AuthKeyId, err = d.Int64()
+
+			return nil
+		},
+	}
+
+	if f, ok := decodeF[m.ClazzID]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", m.ClazzID)
+	}
+}
+
 // Vector api result type
 // ----------------------------------------------------------------------------
 // VectorResList <--
@@ -525,4 +683,7 @@ type RPCSession interface {
 	SessionPushUpdatesData(ctx context.Context, in *TLSessionPushUpdatesData) (*tg.Bool, error)
 	SessionPushSessionUpdatesData(ctx context.Context, in *TLSessionPushSessionUpdatesData) (*tg.Bool, error)
 	SessionPushRpcResultData(ctx context.Context, in *TLSessionPushRpcResultData) (*tg.Bool, error)
+	SessionInvalidateAuthKey(ctx context.Context, in *TLSessionInvalidateAuthKey) (*tg.Bool, error)
+	SessionBindTempAuthKey(ctx context.Context, in *TLSessionBindTempAuthKey) (*tg.Bool, error)
+	SessionQueryAuthKeyInfo(ctx context.Context, in *TLSessionQueryAuthKeyInfo) (*SessionAuthKeyInfo, error)
 }
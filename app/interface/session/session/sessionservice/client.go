@@ -31,6 +31,9 @@ type Client interface {
 	SessionPushUpdatesData(ctx context.Context, req *session.TLSessionPushUpdatesData, callOptions ...callopt.Option) (r *tg.Bool, err error)
 	SessionPushSessionUpdatesData(ctx context.Context, req *session.TLSessionPushSessionUpdatesData, callOptions ...callopt.Option) (r *tg.Bool, err error)
 	SessionPushRpcResultData(ctx context.Context, req *session.TLSessionPushRpcResultData, callOptions ...callopt.Option) (r *tg.Bool, err error)
+	SessionInvalidateAuthKey(ctx context.Context, req *session.TLSessionInvalidateAuthKey, callOptions ...callopt.Option) (r *tg.Bool, err error)
+	SessionBindTempAuthKey(ctx context.Context, req *session.TLSessionBindTempAuthKey, callOptions ...callopt.Option) (r *tg.Bool, err error)
+	SessionQueryAuthKeyInfo(ctx context.Context, req *session.TLSessionQueryAuthKeyInfo, callOptions ...callopt.Option) (r *session.SessionAuthKeyInfo, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -106,3 +109,18 @@ func (p *kSessionClient) SessionPushRpcResultData(ctx context.Context, req *sess
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.SessionPushRpcResultData(ctx, req)
 }
+
+func (p *kSessionClient) SessionInvalidateAuthKey(ctx context.Context, req *session.TLSessionInvalidateAuthKey, callOptions ...callopt.Option) (r *tg.Bool, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SessionInvalidateAuthKey(ctx, req)
+}
+
+func (p *kSessionClient) SessionBindTempAuthKey(ctx context.Context, req *session.TLSessionBindTempAuthKey, callOptions ...callopt.Option) (r *tg.Bool, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SessionBindTempAuthKey(ctx, req)
+}
+
+func (p *kSessionClient) SessionQueryAuthKeyInfo(ctx context.Context, req *session.TLSessionQueryAuthKeyInfo, callOptions ...callopt.Option) (r *session.SessionAuthKeyInfo, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SessionQueryAuthKeyInfo(ctx, req)
+}
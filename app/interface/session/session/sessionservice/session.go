@@ -90,6 +90,27 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingUnary),
 	),
+	"session.invalidateAuthKey": kitex.NewMethodInfo(
+		invalidateAuthKeyHandler,
+		newInvalidateAuthKeyArgs,
+		newInvalidateAuthKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"session.bindTempAuthKey": kitex.NewMethodInfo(
+		bindTempAuthKeyHandler,
+		newBindTempAuthKeyArgs,
+		newBindTempAuthKeyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
+	"session.queryAuthKeyInfo": kitex.NewMethodInfo(
+		queryAuthKeyInfoHandler,
+		newQueryAuthKeyInfoArgs,
+		newQueryAuthKeyInfoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingUnary),
+	),
 }
 
 var (
@@ -1290,6 +1311,384 @@ func (p *PushRpcResultDataResult) GetResult() interface{} {
 	return p.Success
 }
 
+func invalidateAuthKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*InvalidateAuthKeyArgs)
+	realResult := result.(*InvalidateAuthKeyResult)
+	success, err := handler.(session.RPCSession).SessionInvalidateAuthKey(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newInvalidateAuthKeyArgs() interface{} {
+	return &InvalidateAuthKeyArgs{}
+}
+
+func newInvalidateAuthKeyResult() interface{} {
+	return &InvalidateAuthKeyResult{}
+}
+
+type InvalidateAuthKeyArgs struct {
+	Req *session.TLSessionInvalidateAuthKey
+}
+
+func (p *InvalidateAuthKeyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in InvalidateAuthKeyArgs")
+	}
+	return json.Marshal(p.Req)
+}
+
+func (p *InvalidateAuthKeyArgs) Unmarshal(in []byte) error {
+	msg := new(session.TLSessionInvalidateAuthKey)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+func (p *InvalidateAuthKeyArgs) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetReq() {
+		return fmt.Errorf("No req in InvalidateAuthKeyArgs")
+	}
+
+	return p.Req.Encode(x, layer)
+}
+
+func (p *InvalidateAuthKeyArgs) Decode(d *bin.Decoder) (err error) {
+	msg := new(session.TLSessionInvalidateAuthKey)
+	msg.ClazzID, _ = d.ClazzID()
+	msg.Decode(d)
+	p.Req = msg
+	return nil
+}
+
+var InvalidateAuthKeyArgs_Req_DEFAULT *session.TLSessionInvalidateAuthKey
+
+func (p *InvalidateAuthKeyArgs) GetReq() *session.TLSessionInvalidateAuthKey {
+	if !p.IsSetReq() {
+		return InvalidateAuthKeyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *InvalidateAuthKeyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type InvalidateAuthKeyResult struct {
+	Success *tg.Bool
+}
+
+var InvalidateAuthKeyResult_Success_DEFAULT *tg.Bool
+
+func (p *InvalidateAuthKeyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in InvalidateAuthKeyResult")
+	}
+	return json.Marshal(p.Success)
+}
+
+func (p *InvalidateAuthKeyResult) Unmarshal(in []byte) error {
+	msg := new(tg.Bool)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *InvalidateAuthKeyResult) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetSuccess() {
+		return fmt.Errorf("No req in InvalidateAuthKeyResult")
+	}
+
+	return p.Success.Encode(x, layer)
+}
+
+func (p *InvalidateAuthKeyResult) Decode(d *bin.Decoder) (err error) {
+	msg := new(tg.Bool)
+	if err = msg.Decode(d); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *InvalidateAuthKeyResult) GetSuccess() *tg.Bool {
+	if !p.IsSetSuccess() {
+		return InvalidateAuthKeyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *InvalidateAuthKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*tg.Bool)
+}
+
+func (p *InvalidateAuthKeyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *InvalidateAuthKeyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func bindTempAuthKeyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*BindTempAuthKeyArgs)
+	realResult := result.(*BindTempAuthKeyResult)
+	success, err := handler.(session.RPCSession).SessionBindTempAuthKey(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newBindTempAuthKeyArgs() interface{} {
+	return &BindTempAuthKeyArgs{}
+}
+
+func newBindTempAuthKeyResult() interface{} {
+	return &BindTempAuthKeyResult{}
+}
+
+type BindTempAuthKeyArgs struct {
+	Req *session.TLSessionBindTempAuthKey
+}
+
+func (p *BindTempAuthKeyArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in BindTempAuthKeyArgs")
+	}
+	return json.Marshal(p.Req)
+}
+
+func (p *BindTempAuthKeyArgs) Unmarshal(in []byte) error {
+	msg := new(session.TLSessionBindTempAuthKey)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+func (p *BindTempAuthKeyArgs) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetReq() {
+		return fmt.Errorf("No req in BindTempAuthKeyArgs")
+	}
+
+	return p.Req.Encode(x, layer)
+}
+
+func (p *BindTempAuthKeyArgs) Decode(d *bin.Decoder) (err error) {
+	msg := new(session.TLSessionBindTempAuthKey)
+	msg.ClazzID, _ = d.ClazzID()
+	msg.Decode(d)
+	p.Req = msg
+	return nil
+}
+
+var BindTempAuthKeyArgs_Req_DEFAULT *session.TLSessionBindTempAuthKey
+
+func (p *BindTempAuthKeyArgs) GetReq() *session.TLSessionBindTempAuthKey {
+	if !p.IsSetReq() {
+		return BindTempAuthKeyArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *BindTempAuthKeyArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type BindTempAuthKeyResult struct {
+	Success *tg.Bool
+}
+
+var BindTempAuthKeyResult_Success_DEFAULT *tg.Bool
+
+func (p *BindTempAuthKeyResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in BindTempAuthKeyResult")
+	}
+	return json.Marshal(p.Success)
+}
+
+func (p *BindTempAuthKeyResult) Unmarshal(in []byte) error {
+	msg := new(tg.Bool)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BindTempAuthKeyResult) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetSuccess() {
+		return fmt.Errorf("No req in BindTempAuthKeyResult")
+	}
+
+	return p.Success.Encode(x, layer)
+}
+
+func (p *BindTempAuthKeyResult) Decode(d *bin.Decoder) (err error) {
+	msg := new(tg.Bool)
+	if err = msg.Decode(d); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *BindTempAuthKeyResult) GetSuccess() *tg.Bool {
+	if !p.IsSetSuccess() {
+		return BindTempAuthKeyResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *BindTempAuthKeyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*tg.Bool)
+}
+
+func (p *BindTempAuthKeyResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *BindTempAuthKeyResult) GetResult() interface{} {
+	return p.Success
+}
+
+func queryAuthKeyInfoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*QueryAuthKeyInfoArgs)
+	realResult := result.(*QueryAuthKeyInfoResult)
+	success, err := handler.(session.RPCSession).SessionQueryAuthKeyInfo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+
+func newQueryAuthKeyInfoArgs() interface{} {
+	return &QueryAuthKeyInfoArgs{}
+}
+
+func newQueryAuthKeyInfoResult() interface{} {
+	return &QueryAuthKeyInfoResult{}
+}
+
+type QueryAuthKeyInfoArgs struct {
+	Req *session.TLSessionQueryAuthKeyInfo
+}
+
+func (p *QueryAuthKeyInfoArgs) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetReq() {
+		return out, fmt.Errorf("No req in QueryAuthKeyInfoArgs")
+	}
+	return json.Marshal(p.Req)
+}
+
+func (p *QueryAuthKeyInfoArgs) Unmarshal(in []byte) error {
+	msg := new(session.TLSessionQueryAuthKeyInfo)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Req = msg
+	return nil
+}
+
+func (p *QueryAuthKeyInfoArgs) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetReq() {
+		return fmt.Errorf("No req in QueryAuthKeyInfoArgs")
+	}
+
+	return p.Req.Encode(x, layer)
+}
+
+func (p *QueryAuthKeyInfoArgs) Decode(d *bin.Decoder) (err error) {
+	msg := new(session.TLSessionQueryAuthKeyInfo)
+	msg.ClazzID, _ = d.ClazzID()
+	msg.Decode(d)
+	p.Req = msg
+	return nil
+}
+
+var QueryAuthKeyInfoArgs_Req_DEFAULT *session.TLSessionQueryAuthKeyInfo
+
+func (p *QueryAuthKeyInfoArgs) GetReq() *session.TLSessionQueryAuthKeyInfo {
+	if !p.IsSetReq() {
+		return QueryAuthKeyInfoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+
+func (p *QueryAuthKeyInfoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+type QueryAuthKeyInfoResult struct {
+	Success *session.SessionAuthKeyInfo
+}
+
+var QueryAuthKeyInfoResult_Success_DEFAULT *session.SessionAuthKeyInfo
+
+func (p *QueryAuthKeyInfoResult) Marshal(out []byte) ([]byte, error) {
+	if !p.IsSetSuccess() {
+		return out, fmt.Errorf("No req in QueryAuthKeyInfoResult")
+	}
+	return json.Marshal(p.Success)
+}
+
+func (p *QueryAuthKeyInfoResult) Unmarshal(in []byte) error {
+	msg := new(session.SessionAuthKeyInfo)
+	if err := json.Unmarshal(in, msg); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *QueryAuthKeyInfoResult) Encode(x *bin.Encoder, layer int32) error {
+	if !p.IsSetSuccess() {
+		return fmt.Errorf("No req in QueryAuthKeyInfoResult")
+	}
+
+	return p.Success.Encode(x, layer)
+}
+
+func (p *QueryAuthKeyInfoResult) Decode(d *bin.Decoder) (err error) {
+	msg := new(session.SessionAuthKeyInfo)
+	if err = msg.Decode(d); err != nil {
+		return err
+	}
+	p.Success = msg
+	return nil
+}
+
+func (p *QueryAuthKeyInfoResult) GetSuccess() *session.SessionAuthKeyInfo {
+	if !p.IsSetSuccess() {
+		return QueryAuthKeyInfoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+
+func (p *QueryAuthKeyInfoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*session.SessionAuthKeyInfo)
+}
+
+func (p *QueryAuthKeyInfoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *QueryAuthKeyInfoResult) GetResult() interface{} {
+	return p.Success
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -1389,3 +1788,33 @@ func (p *kClient) SessionPushRpcResultData(ctx context.Context, req *session.TLS
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) SessionInvalidateAuthKey(ctx context.Context, req *session.TLSessionInvalidateAuthKey) (r *tg.Bool, err error) {
+	var _args InvalidateAuthKeyArgs
+	_args.Req = req
+	var _result InvalidateAuthKeyResult
+	if err = p.c.Call(ctx, "session.invalidateAuthKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SessionBindTempAuthKey(ctx context.Context, req *session.TLSessionBindTempAuthKey) (r *tg.Bool, err error) {
+	var _args BindTempAuthKeyArgs
+	_args.Req = req
+	var _result BindTempAuthKeyResult
+	if err = p.c.Call(ctx, "session.bindTempAuthKey", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SessionQueryAuthKeyInfo(ctx context.Context, req *session.TLSessionQueryAuthKeyInfo) (r *session.SessionAuthKeyInfo, err error) {
+	var _args QueryAuthKeyInfoArgs
+	_args.Req = req
+	var _result QueryAuthKeyInfoResult
+	if err = p.c.Call(ctx, "session.queryAuthKeyInfo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -14,6 +14,7 @@ const (
 	Predicate_sessionClientEvent             = "sessionClientEvent"
 	Predicate_sessionClientData              = "sessionClientData"
 	Predicate_httpSessionData                = "httpSessionData"
+	Predicate_sessionAuthKeyInfo             = "sessionAuthKeyInfo"
 	Predicate_session_queryAuthKey           = "session_queryAuthKey"
 	Predicate_session_setAuthKey             = "session_setAuthKey"
 	Predicate_session_createSession          = "session_createSession"
@@ -23,6 +24,9 @@ const (
 	Predicate_session_pushUpdatesData        = "session_pushUpdatesData"
 	Predicate_session_pushSessionUpdatesData = "session_pushSessionUpdatesData"
 	Predicate_session_pushRpcResultData      = "session_pushRpcResultData"
+	Predicate_session_invalidateAuthKey      = "session_invalidateAuthKey"
+	Predicate_session_bindTempAuthKey        = "session_bindTempAuthKey"
+	Predicate_session_queryAuthKeyInfo       = "session_queryAuthKeyInfo"
 )
 
 var clazzNameRegisters2 = map[string]map[int]int32{
@@ -37,6 +41,10 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 	Predicate_httpSessionData: {
 		0: -606579889, // 0xdbd8534f
 
+	},
+	Predicate_sessionAuthKeyInfo: {
+		0: 318045887, // 0x12f4febf
+
 	},
 	Predicate_session_queryAuthKey: {
 		0: 1798174801, // 0x6b2df851
@@ -74,12 +82,25 @@ var clazzNameRegisters2 = map[string]map[int]int32{
 		0: 1262947465, // 0x4b470c89
 
 	},
+	Predicate_session_invalidateAuthKey: {
+		0: -860456465, // 0xccb679ef
+
+	},
+	Predicate_session_bindTempAuthKey: {
+		0: -1601034388, // 0xa092276c
+
+	},
+	Predicate_session_queryAuthKeyInfo: {
+		0: 701517553, // 0x29d04ef1
+
+	},
 }
 
 var clazzIdNameRegisters2 = map[int32]string{
 	-243320993:  Predicate_sessionClientEvent,             // 0xf17f375f
 	1101139022:  Predicate_sessionClientData,              // 0x41a20c4e
 	-606579889:  Predicate_httpSessionData,                // 0xdbd8534f
+	318045887:   Predicate_sessionAuthKeyInfo,             // 0x12f4febf
 	1798174801:  Predicate_session_queryAuthKey,           // 0x6b2df851
 	487672075:   Predicate_session_setAuthKey,             // 0x1d11490b
 	1091351053:  Predicate_session_createSession,          // 0x410cb20d
@@ -89,6 +110,9 @@ var clazzIdNameRegisters2 = map[int32]string{
 	-1519069143: Predicate_session_pushUpdatesData,        // 0xa574d829
 	1173618080:  Predicate_session_pushSessionUpdatesData, // 0x45f3fda0
 	1262947465:  Predicate_session_pushRpcResultData,      // 0x4b470c89
+	-860456465:  Predicate_session_invalidateAuthKey,      // 0xccb679ef
+	-1601034388: Predicate_session_bindTempAuthKey,        // 0xa092276c
+	701517553:   Predicate_session_queryAuthKeyInfo,       // 0x29d04ef1
 
 }
 
@@ -34,6 +34,11 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 		o.Data2.Constructor = -606579889
 		return o
 	},
+	318045887: func() mtproto.TLObject { // 0x12f4febf
+		o := MakeTLSessionAuthKeyInfo(nil)
+		o.Data2.Constructor = 318045887
+		return o
+	},
 	1101139022: func() mtproto.TLObject { // 0x41a20c4e
 		o := MakeTLSessionClientData(nil)
 		o.Data2.Constructor = 1101139022
@@ -91,6 +96,21 @@ var clazzIdRegisters2 = map[int32]func() mtproto.TLObject{
 			Constructor: 1262947465,
 		}
 	},
+	-860456465: func() mtproto.TLObject { // 0xccb679ef
+		return &TLSessionInvalidateAuthKey{
+			Constructor: -860456465,
+		}
+	},
+	-1601034388: func() mtproto.TLObject { // 0xa092276c
+		return &TLSessionBindTempAuthKey{
+			Constructor: -1601034388,
+		}
+	},
+	701517553: func() mtproto.TLObject { // 0x29d04ef1
+		return &TLSessionQueryAuthKeyInfo{
+			Constructor: 701517553,
+		}
+	},
 }
 
 func NewTLObjectByClassID(classId int32) mtproto.TLObject {
@@ -224,6 +244,131 @@ func (m *TLHttpSessionData) Decode(dBuf *mtproto.DecodeBuf) error {
 	}
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// SessionAuthKeyInfo <--
+//  + TL_SessionAuthKeyInfo
+//
+
+func (m *SessionAuthKeyInfo) Encode(x *mtproto.EncodeBuf, layer int32) []byte {
+	predicateName := m.PredicateName
+	if predicateName == "" {
+		if n, ok := clazzIdNameRegisters2[int32(m.Constructor)]; ok {
+			predicateName = n
+		}
+	}
+
+	switch predicateName {
+	case Predicate_sessionAuthKeyInfo:
+		t := m.To_SessionAuthKeyInfo()
+		t.Encode(x, layer)
+
+	default:
+		// logx.Errorf("invalid predicate error: %s",  m.PredicateName)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *SessionAuthKeyInfo) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *SessionAuthKeyInfo) Decode(dBuf *mtproto.DecodeBuf) error {
+	m.Constructor = TLConstructor(dBuf.Int())
+	switch uint32(m.Constructor) {
+	case 0x12f4febf:
+		m2 := MakeTLSessionAuthKeyInfo(m)
+		m2.Decode(dBuf)
+
+	default:
+		return fmt.Errorf("invalid constructorId: 0x%x", uint32(m.Constructor))
+	}
+	return dBuf.GetError()
+}
+
+// To_SessionAuthKeyInfo
+func (m *SessionAuthKeyInfo) To_SessionAuthKeyInfo() *TLSessionAuthKeyInfo {
+	m.PredicateName = Predicate_sessionAuthKeyInfo
+	return &TLSessionAuthKeyInfo{
+		Data2: m,
+	}
+}
+
+// MakeTLSessionAuthKeyInfo
+func MakeTLSessionAuthKeyInfo(data2 *SessionAuthKeyInfo) *TLSessionAuthKeyInfo {
+	if data2 == nil {
+		return &TLSessionAuthKeyInfo{Data2: &SessionAuthKeyInfo{
+			PredicateName: Predicate_sessionAuthKeyInfo,
+		}}
+	} else {
+		data2.PredicateName = Predicate_sessionAuthKeyInfo
+		return &TLSessionAuthKeyInfo{Data2: data2}
+	}
+}
+
+func (m *TLSessionAuthKeyInfo) To_SessionAuthKeyInfo() *SessionAuthKeyInfo {
+	m.Data2.PredicateName = Predicate_sessionAuthKeyInfo
+	return m.Data2
+}
+
+func (m *TLSessionAuthKeyInfo) SetAuthKey(v *mtproto.AuthKeyInfo) { m.Data2.AuthKey = v }
+func (m *TLSessionAuthKeyInfo) GetAuthKey() *mtproto.AuthKeyInfo  { return m.Data2.AuthKey }
+
+func (m *TLSessionAuthKeyInfo) SetExpiresAt(v int64) { m.Data2.ExpiresAt = v }
+func (m *TLSessionAuthKeyInfo) GetExpiresAt() int64  { return m.Data2.ExpiresAt }
+
+func (m *TLSessionAuthKeyInfo) GetPredicateName() string {
+	return Predicate_sessionAuthKeyInfo
+}
+
+func (m *TLSessionAuthKeyInfo) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	var encodeF = map[uint32]func() error{
+		0x12f4febf: func() error {
+			x.UInt(0x12f4febf)
+
+			m.GetAuthKey().Encode(x, layer)
+			x.Long(m.GetExpiresAt())
+			return nil
+		},
+	}
+
+	clazzId := GetClazzID(Predicate_sessionAuthKeyInfo, int(layer))
+	if f, ok := encodeF[uint32(clazzId)]; ok {
+		return f()
+	} else {
+		// TODO(@benqi): handle error
+		// log.Errorf("not found clazzId by (%s, %d)", Predicate_sessionAuthKeyInfo, layer)
+		return nil
+	}
+
+	return nil
+}
+
+func (m *TLSessionAuthKeyInfo) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionAuthKeyInfo) Decode(dBuf *mtproto.DecodeBuf) error {
+	var decodeF = map[uint32]func() error{
+		0x12f4febf: func() error {
+
+			m0 := &mtproto.AuthKeyInfo{}
+			m0.Decode(dBuf)
+			m.SetAuthKey(m0)
+
+			m.SetExpiresAt(dBuf.Long())
+			return dBuf.GetError()
+		},
+	}
+
+	if f, ok := decodeF[uint32(m.Data2.Constructor)]; ok {
+		return f()
+	} else {
+		return fmt.Errorf("invalid constructor: %x", uint32(m.Data2.Constructor))
+	}
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // SessionClientData <--
 //  + TL_SessionClientData
@@ -938,3 +1083,135 @@ func (m *TLSessionPushRpcResultData) Decode(dBuf *mtproto.DecodeBuf) error {
 	}
 	return dBuf.GetError()
 }
+
+// TLSessionInvalidateAuthKey
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSessionInvalidateAuthKey) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0xccb679ef:
+		x.UInt(0xccb679ef)
+
+		// set flags
+		var flags uint32 = 0
+
+		if m.GetDestroyed() == true {
+			flags |= 1 << 0
+		}
+
+		x.UInt(flags)
+
+		// flags Debug by @benqi
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLSessionInvalidateAuthKey) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionInvalidateAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xccb679ef:
+
+		flags := dBuf.UInt()
+		_ = flags
+
+		// flags Debug by @benqi
+		m.AuthKeyId = dBuf.Long()
+		if (flags & (1 << 0)) != 0 {
+			m.Destroyed = true
+		}
+
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+// TLSessionBindTempAuthKey
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSessionBindTempAuthKey) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0xa092276c:
+		x.UInt(0xa092276c)
+
+		// no flags
+
+		x.Long(m.GetPermAuthKeyId())
+		x.Long(m.GetTempAuthKeyId())
+		x.Long(m.GetExpiresAt())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLSessionBindTempAuthKey) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionBindTempAuthKey) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0xa092276c:
+
+		// not has flags
+
+		m.PermAuthKeyId = dBuf.Long()
+		m.TempAuthKeyId = dBuf.Long()
+		m.ExpiresAt = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
+
+// TLSessionQueryAuthKeyInfo
+///////////////////////////////////////////////////////////////////////////////
+
+func (m *TLSessionQueryAuthKeyInfo) Encode(x *mtproto.EncodeBuf, layer int32) error {
+	switch uint32(m.Constructor) {
+	case 0x29d04ef1:
+		x.UInt(0x29d04ef1)
+
+		// no flags
+
+		x.Long(m.GetAuthKeyId())
+
+	default:
+		// log.Errorf("")
+	}
+
+	return nil
+}
+
+func (m *TLSessionQueryAuthKeyInfo) CalcByteSize(layer int32) int {
+	return 0
+}
+
+func (m *TLSessionQueryAuthKeyInfo) Decode(dBuf *mtproto.DecodeBuf) error {
+	switch uint32(m.Constructor) {
+	case 0x29d04ef1:
+
+		// not has flags
+
+		m.AuthKeyId = dBuf.Long()
+		return dBuf.GetError()
+
+	default:
+		// log.Errorf("")
+	}
+	return dBuf.GetError()
+}
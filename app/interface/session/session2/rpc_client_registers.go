@@ -35,6 +35,9 @@ var rpcContextRegisters = map[string]RPCContextTuple{
 	"TLSessionPushUpdatesData":        RPCContextTuple{"/mtproto.RPCSession/session_pushUpdatesData", func() interface{} { return new(mtproto.Bool) }},
 	"TLSessionPushSessionUpdatesData": RPCContextTuple{"/mtproto.RPCSession/session_pushSessionUpdatesData", func() interface{} { return new(mtproto.Bool) }},
 	"TLSessionPushRpcResultData":      RPCContextTuple{"/mtproto.RPCSession/session_pushRpcResultData", func() interface{} { return new(mtproto.Bool) }},
+	"TLSessionInvalidateAuthKey":      RPCContextTuple{"/mtproto.RPCSession/session_invalidateAuthKey", func() interface{} { return new(mtproto.Bool) }},
+	"TLSessionBindTempAuthKey":        RPCContextTuple{"/mtproto.RPCSession/session_bindTempAuthKey", func() interface{} { return new(mtproto.Bool) }},
+	"TLSessionQueryAuthKeyInfo":       RPCContextTuple{"/mtproto.RPCSession/session_queryAuthKeyInfo", func() interface{} { return new(SessionAuthKeyInfo) }},
 }
 
 func FindRPCContextTuple(t interface{}) *RPCContextTuple {
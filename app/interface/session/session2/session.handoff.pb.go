@@ -0,0 +1,539 @@
+//
+// Copyright (c) 2024-present,  Teamgram Authors.
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v5.26.1
+// source: session.handoff.proto
+
+package session
+
+import (
+	mtproto "github.com/teamgram/proto/mtproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SessionHandoffChunk carries the state of some auth keys from the session node that owned
+// them to the one that owns them now, a handoff is a stream of chunks.
+type SessionHandoffChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromServerId string                 `protobuf:"bytes,1,opt,name=from_server_id,json=fromServerId,proto3" json:"from_server_id,omitempty"`
+	States       []*SessionAuthKeyState `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *SessionHandoffChunk) Reset() {
+	*x = SessionHandoffChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_handoff_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionHandoffChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionHandoffChunk) ProtoMessage() {}
+
+func (x *SessionHandoffChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_session_handoff_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionHandoffChunk.ProtoReflect.Descriptor instead.
+func (*SessionHandoffChunk) Descriptor() ([]byte, []int) {
+	return file_session_handoff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SessionHandoffChunk) GetFromServerId() string {
+	if x != nil {
+		return x.FromServerId
+	}
+	return ""
+}
+
+func (x *SessionHandoffChunk) GetStates() []*SessionAuthKeyState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+// SessionAuthKeyState is everything a session node keeps for one owning auth key,
+// the perm key or a temp key not bound yet.
+type SessionAuthKeyState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthKeyId int64 `protobuf:"varint,1,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	// the gnetways with sessions of auth_key_id or of the temp keys bound to it
+	Gateways []*SessionAuthKeyGateway `protobuf:"bytes,2,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	// the directory entry if auth_key_id is a bound temp key
+	BoundPermAuthKeyId int64 `protobuf:"varint,3,opt,name=bound_perm_auth_key_id,json=boundPermAuthKeyId,proto3" json:"bound_perm_auth_key_id,omitempty"`
+	BoundExpiresAt     int64 `protobuf:"varint,4,opt,name=bound_expires_at,json=boundExpiresAt,proto3" json:"bound_expires_at,omitempty"`
+	// the queues, salts and pending rpcs of the session core, opaque here
+	Sessions []byte `protobuf:"bytes,5,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	// the keys the gnetways set here, auth_key_id and the temp keys bound to it
+	AuthKeys []*SessionHandoffAuthKey `protobuf:"bytes,6,rep,name=auth_keys,json=authKeys,proto3" json:"auth_keys,omitempty"`
+}
+
+func (x *SessionAuthKeyState) Reset() {
+	*x = SessionAuthKeyState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_handoff_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionAuthKeyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAuthKeyState) ProtoMessage() {}
+
+func (x *SessionAuthKeyState) ProtoReflect() protoreflect.Message {
+	mi := &file_session_handoff_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAuthKeyState.ProtoReflect.Descriptor instead.
+func (*SessionAuthKeyState) Descriptor() ([]byte, []int) {
+	return file_session_handoff_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SessionAuthKeyState) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionAuthKeyState) GetGateways() []*SessionAuthKeyGateway {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *SessionAuthKeyState) GetBoundPermAuthKeyId() int64 {
+	if x != nil {
+		return x.BoundPermAuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionAuthKeyState) GetBoundExpiresAt() int64 {
+	if x != nil {
+		return x.BoundExpiresAt
+	}
+	return 0
+}
+
+func (x *SessionAuthKeyState) GetSessions() []byte {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *SessionAuthKeyState) GetAuthKeys() []*SessionHandoffAuthKey {
+	if x != nil {
+		return x.AuthKeys
+	}
+	return nil
+}
+
+type SessionHandoffAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthKey   *mtproto.AuthKeyInfo `protobuf:"bytes,1,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
+	ExpiresAt int64                `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SessionHandoffAuthKey) Reset() {
+	*x = SessionHandoffAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_handoff_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionHandoffAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionHandoffAuthKey) ProtoMessage() {}
+
+func (x *SessionHandoffAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_session_handoff_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionHandoffAuthKey.ProtoReflect.Descriptor instead.
+func (*SessionHandoffAuthKey) Descriptor() ([]byte, []int) {
+	return file_session_handoff_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionHandoffAuthKey) GetAuthKey() *mtproto.AuthKeyInfo {
+	if x != nil {
+		return x.AuthKey
+	}
+	return nil
+}
+
+func (x *SessionHandoffAuthKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type SessionAuthKeyGateway struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthKeyId     int64  `protobuf:"varint,1,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	PermAuthKeyId int64  `protobuf:"varint,2,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
+	ServerId      string `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Sessions      int32  `protobuf:"varint,4,opt,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *SessionAuthKeyGateway) Reset() {
+	*x = SessionAuthKeyGateway{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_handoff_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionAuthKeyGateway) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAuthKeyGateway) ProtoMessage() {}
+
+func (x *SessionAuthKeyGateway) ProtoReflect() protoreflect.Message {
+	mi := &file_session_handoff_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAuthKeyGateway.ProtoReflect.Descriptor instead.
+func (*SessionAuthKeyGateway) Descriptor() ([]byte, []int) {
+	return file_session_handoff_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SessionAuthKeyGateway) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionAuthKeyGateway) GetPermAuthKeyId() int64 {
+	if x != nil {
+		return x.PermAuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionAuthKeyGateway) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SessionAuthKeyGateway) GetSessions() int32 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+type SessionHandoffResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Imported int32 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+}
+
+func (x *SessionHandoffResult) Reset() {
+	*x = SessionHandoffResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_handoff_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionHandoffResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionHandoffResult) ProtoMessage() {}
+
+func (x *SessionHandoffResult) ProtoReflect() protoreflect.Message {
+	mi := &file_session_handoff_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionHandoffResult.ProtoReflect.Descriptor instead.
+func (*SessionHandoffResult) Descriptor() ([]byte, []int) {
+	return file_session_handoff_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SessionHandoffResult) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+var File_session_handoff_proto protoreflect.FileDescriptor
+
+var file_session_handoff_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x66,
+	0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x1a, 0x14, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x74, 0x6c, 0x2e, 0x73, 0x79, 0x6e, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x13, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x24, 0x0a,
+	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x13, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x3a, 0x0a, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x52, 0x08, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x12, 0x32, 0x0a,
+	0x16, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x66, 0x66, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x67, 0x0a, 0x15, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2f, 0x0a,
+	0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x99, 0x01,
+	0x0a, 0x15, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x32, 0x0a, 0x14, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x32, 0x67, 0x0a,
+	0x11, 0x52, 0x50, 0x43, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x66, 0x66, 0x12, 0x52, 0x0a, 0x0f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61,
+	0x6e, 0x64, 0x6f, 0x66, 0x66, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x66, 0x66, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x00, 0x28, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x65,
+	0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70,
+	0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_session_handoff_proto_rawDescOnce sync.Once
+	file_session_handoff_proto_rawDescData = file_session_handoff_proto_rawDesc
+)
+
+func file_session_handoff_proto_rawDescGZIP() []byte {
+	file_session_handoff_proto_rawDescOnce.Do(func() {
+		file_session_handoff_proto_rawDescData = protoimpl.X.CompressGZIP(file_session_handoff_proto_rawDescData)
+	})
+	return file_session_handoff_proto_rawDescData
+}
+
+var file_session_handoff_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_session_handoff_proto_goTypes = []any{
+	(*SessionHandoffChunk)(nil),   // 0: session.SessionHandoffChunk
+	(*SessionAuthKeyState)(nil),   // 1: session.SessionAuthKeyState
+	(*SessionHandoffAuthKey)(nil), // 2: session.SessionHandoffAuthKey
+	(*SessionAuthKeyGateway)(nil), // 3: session.SessionAuthKeyGateway
+	(*SessionHandoffResult)(nil),  // 4: session.SessionHandoffResult
+	(*mtproto.AuthKeyInfo)(nil),   // 5: mtproto.AuthKeyInfo
+}
+var file_session_handoff_proto_depIdxs = []int32{
+	1, // 0: session.SessionHandoffChunk.states:type_name -> session.SessionAuthKeyState
+	3, // 1: session.SessionAuthKeyState.gateways:type_name -> session.SessionAuthKeyGateway
+	2, // 2: session.SessionAuthKeyState.auth_keys:type_name -> session.SessionHandoffAuthKey
+	5, // 3: session.SessionHandoffAuthKey.auth_key:type_name -> mtproto.AuthKeyInfo
+	0, // 4: session.RPCSessionHandoff.session_handoff:input_type -> session.SessionHandoffChunk
+	4, // 5: session.RPCSessionHandoff.session_handoff:output_type -> session.SessionHandoffResult
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_session_handoff_proto_init() }
+func file_session_handoff_proto_init() {
+	if File_session_handoff_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_session_handoff_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionHandoffChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_handoff_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionAuthKeyState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_handoff_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionHandoffAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_handoff_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionAuthKeyGateway); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_handoff_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionHandoffResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_session_handoff_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_session_handoff_proto_goTypes,
+		DependencyIndexes: file_session_handoff_proto_depIdxs,
+		MessageInfos:      file_session_handoff_proto_msgTypes,
+	}.Build()
+	File_session_handoff_proto = out.File
+	file_session_handoff_proto_rawDesc = nil
+	file_session_handoff_proto_goTypes = nil
+	file_session_handoff_proto_depIdxs = nil
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (c) 2024-present,  Teamgram Authors.
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: session.handoff.proto
+
+package session
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RPCSessionHandoff_SessionHandoff_FullMethodName = "/session.RPCSessionHandoff/session_handoff"
+)
+
+// RPCSessionHandoffClient is the client API for RPCSessionHandoff service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RPCSessionHandoffClient interface {
+	SessionHandoff(ctx context.Context, opts ...grpc.CallOption) (RPCSessionHandoff_SessionHandoffClient, error)
+}
+
+type rPCSessionHandoffClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRPCSessionHandoffClient(cc grpc.ClientConnInterface) RPCSessionHandoffClient {
+	return &rPCSessionHandoffClient{cc}
+}
+
+func (c *rPCSessionHandoffClient) SessionHandoff(ctx context.Context, opts ...grpc.CallOption) (RPCSessionHandoff_SessionHandoffClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPCSessionHandoff_ServiceDesc.Streams[0], RPCSessionHandoff_SessionHandoff_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPCSessionHandoffSessionHandoffClient{stream}
+	return x, nil
+}
+
+type RPCSessionHandoff_SessionHandoffClient interface {
+	Send(*SessionHandoffChunk) error
+	CloseAndRecv() (*SessionHandoffResult, error)
+	grpc.ClientStream
+}
+
+type rPCSessionHandoffSessionHandoffClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPCSessionHandoffSessionHandoffClient) Send(m *SessionHandoffChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rPCSessionHandoffSessionHandoffClient) CloseAndRecv() (*SessionHandoffResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(SessionHandoffResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RPCSessionHandoffServer is the server API for RPCSessionHandoff service.
+// All implementations should embed UnimplementedRPCSessionHandoffServer
+// for forward compatibility
+type RPCSessionHandoffServer interface {
+	SessionHandoff(RPCSessionHandoff_SessionHandoffServer) error
+}
+
+// UnimplementedRPCSessionHandoffServer should be embedded to have forward compatible implementations.
+type UnimplementedRPCSessionHandoffServer struct {
+}
+
+func (UnimplementedRPCSessionHandoffServer) SessionHandoff(RPCSessionHandoff_SessionHandoffServer) error {
+	return status.Errorf(codes.Unimplemented, "method SessionHandoff not implemented")
+}
+
+// UnsafeRPCSessionHandoffServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RPCSessionHandoffServer will
+// result in compilation errors.
+type UnsafeRPCSessionHandoffServer interface {
+	mustEmbedUnimplementedRPCSessionHandoffServer()
+}
+
+func RegisterRPCSessionHandoffServer(s grpc.ServiceRegistrar, srv RPCSessionHandoffServer) {
+	s.RegisterService(&RPCSessionHandoff_ServiceDesc, srv)
+}
+
+func _RPCSessionHandoff_SessionHandoff_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RPCSessionHandoffServer).SessionHandoff(&rPCSessionHandoffSessionHandoffServer{stream})
+}
+
+type RPCSessionHandoff_SessionHandoffServer interface {
+	SendAndClose(*SessionHandoffResult) error
+	Recv() (*SessionHandoffChunk, error)
+	grpc.ServerStream
+}
+
+type rPCSessionHandoffSessionHandoffServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPCSessionHandoffSessionHandoffServer) SendAndClose(m *SessionHandoffResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rPCSessionHandoffSessionHandoffServer) Recv() (*SessionHandoffChunk, error) {
+	m := new(SessionHandoffChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RPCSessionHandoff_ServiceDesc is the grpc.ServiceDesc for RPCSessionHandoff service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RPCSessionHandoff_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "session.RPCSessionHandoff",
+	HandlerType: (*RPCSessionHandoffServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "session_handoff",
+			Handler:       _RPCSessionHandoff_SessionHandoff_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "session.handoff.proto",
+}
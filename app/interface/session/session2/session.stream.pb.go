@@ -0,0 +1,548 @@
+//
+// Copyright (c) 2024-present,  Teamgram Authors.
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v5.26.1
+// source: session.stream.proto
+
+package session
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SessionStreamFrame is one batch on the long-lived stream between a gnetway and a session node.
+// Each side numbers its frames (seq > 0), acks the frames of the peer and grants the peer
+// a window of frames it may send beyond the last ack. Frames with seq = 0 only carry control.
+type SessionStreamFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch    int64                `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Seq      int64                `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Ack      int64                `protobuf:"varint,3,opt,name=ack,proto3" json:"ack,omitempty"`
+	Window   int32                `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	ServerId string               `protobuf:"bytes,5,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Items    []*SessionStreamItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *SessionStreamFrame) Reset() {
+	*x = SessionStreamFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStreamFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStreamFrame) ProtoMessage() {}
+
+func (x *SessionStreamFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_session_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStreamFrame.ProtoReflect.Descriptor instead.
+func (*SessionStreamFrame) Descriptor() ([]byte, []int) {
+	return file_session_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SessionStreamFrame) GetEpoch() int64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *SessionStreamFrame) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SessionStreamFrame) GetAck() int64 {
+	if x != nil {
+		return x.Ack
+	}
+	return 0
+}
+
+func (x *SessionStreamFrame) GetWindow() int32 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *SessionStreamFrame) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *SessionStreamFrame) GetItems() []*SessionStreamItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SessionStreamItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*SessionStreamItem_CreateSession
+	//	*SessionStreamItem_SendData
+	//	*SessionStreamItem_CloseSession
+	//	*SessionStreamItem_GatewayData
+	//	*SessionStreamItem_InvalidateAuthKey
+	Item isSessionStreamItem_Item `protobuf_oneof:"item"`
+}
+
+func (x *SessionStreamItem) Reset() {
+	*x = SessionStreamItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStreamItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStreamItem) ProtoMessage() {}
+
+func (x *SessionStreamItem) ProtoReflect() protoreflect.Message {
+	mi := &file_session_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStreamItem.ProtoReflect.Descriptor instead.
+func (*SessionStreamItem) Descriptor() ([]byte, []int) {
+	return file_session_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *SessionStreamItem) GetItem() isSessionStreamItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *SessionStreamItem) GetCreateSession() *SessionClientEvent {
+	if x, ok := x.GetItem().(*SessionStreamItem_CreateSession); ok {
+		return x.CreateSession
+	}
+	return nil
+}
+
+func (x *SessionStreamItem) GetSendData() *SessionClientData {
+	if x, ok := x.GetItem().(*SessionStreamItem_SendData); ok {
+		return x.SendData
+	}
+	return nil
+}
+
+func (x *SessionStreamItem) GetCloseSession() *SessionClientEvent {
+	if x, ok := x.GetItem().(*SessionStreamItem_CloseSession); ok {
+		return x.CloseSession
+	}
+	return nil
+}
+
+func (x *SessionStreamItem) GetGatewayData() *SessionStreamGatewayData {
+	if x, ok := x.GetItem().(*SessionStreamItem_GatewayData); ok {
+		return x.GatewayData
+	}
+	return nil
+}
+
+func (x *SessionStreamItem) GetInvalidateAuthKey() *SessionStreamInvalidateAuthKey {
+	if x, ok := x.GetItem().(*SessionStreamItem_InvalidateAuthKey); ok {
+		return x.InvalidateAuthKey
+	}
+	return nil
+}
+
+type isSessionStreamItem_Item interface {
+	isSessionStreamItem_Item()
+}
+
+type SessionStreamItem_CreateSession struct {
+	CreateSession *SessionClientEvent `protobuf:"bytes,1,opt,name=create_session,json=createSession,proto3,oneof"`
+}
+
+type SessionStreamItem_SendData struct {
+	SendData *SessionClientData `protobuf:"bytes,2,opt,name=send_data,json=sendData,proto3,oneof"`
+}
+
+type SessionStreamItem_CloseSession struct {
+	CloseSession *SessionClientEvent `protobuf:"bytes,3,opt,name=close_session,json=closeSession,proto3,oneof"`
+}
+
+type SessionStreamItem_GatewayData struct {
+	GatewayData *SessionStreamGatewayData `protobuf:"bytes,4,opt,name=gateway_data,json=gatewayData,proto3,oneof"`
+}
+
+type SessionStreamItem_InvalidateAuthKey struct {
+	InvalidateAuthKey *SessionStreamInvalidateAuthKey `protobuf:"bytes,5,opt,name=invalidate_auth_key,json=invalidateAuthKey,proto3,oneof"`
+}
+
+func (*SessionStreamItem_CreateSession) isSessionStreamItem_Item() {}
+
+func (*SessionStreamItem_SendData) isSessionStreamItem_Item() {}
+
+func (*SessionStreamItem_CloseSession) isSessionStreamItem_Item() {}
+
+func (*SessionStreamItem_GatewayData) isSessionStreamItem_Item() {}
+
+func (*SessionStreamItem_InvalidateAuthKey) isSessionStreamItem_Item() {}
+
+// SessionStreamGatewayData is gateway.sendDataToGateway
+type SessionStreamGatewayData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthKeyId int64  `protobuf:"varint,1,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	SessionId int64  `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Payload   []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *SessionStreamGatewayData) Reset() {
+	*x = SessionStreamGatewayData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStreamGatewayData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStreamGatewayData) ProtoMessage() {}
+
+func (x *SessionStreamGatewayData) ProtoReflect() protoreflect.Message {
+	mi := &file_session_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStreamGatewayData.ProtoReflect.Descriptor instead.
+func (*SessionStreamGatewayData) Descriptor() ([]byte, []int) {
+	return file_session_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionStreamGatewayData) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionStreamGatewayData) GetSessionId() int64 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *SessionStreamGatewayData) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// SessionStreamInvalidateAuthKey is gateway.invalidateAuthKey
+type SessionStreamInvalidateAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthKeyId int64 `protobuf:"varint,1,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Destroyed bool  `protobuf:"varint,2,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+}
+
+func (x *SessionStreamInvalidateAuthKey) Reset() {
+	*x = SessionStreamInvalidateAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionStreamInvalidateAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionStreamInvalidateAuthKey) ProtoMessage() {}
+
+func (x *SessionStreamInvalidateAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_session_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionStreamInvalidateAuthKey.ProtoReflect.Descriptor instead.
+func (*SessionStreamInvalidateAuthKey) Descriptor() ([]byte, []int) {
+	return file_session_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SessionStreamInvalidateAuthKey) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *SessionStreamInvalidateAuthKey) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+var File_session_stream_proto protoreflect.FileDescriptor
+
+var file_session_stream_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a,
+	0x10, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x74, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x61,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x83, 0x03, 0x0a, 0x11, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x44, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x42, 0x0a, 0x0d, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x0c, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x59, 0x0a, 0x13,
+	0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x48, 0x00, 0x52, 0x11, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22,
+	0x73, 0x0a, 0x18, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x5e, 0x0a, 0x1e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f,
+	0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72,
+	0x6f, 0x79, 0x65, 0x64, 0x32, 0x64, 0x0a, 0x10, 0x52, 0x50, 0x43, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x50, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61,
+	0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_session_stream_proto_rawDescOnce sync.Once
+	file_session_stream_proto_rawDescData = file_session_stream_proto_rawDesc
+)
+
+func file_session_stream_proto_rawDescGZIP() []byte {
+	file_session_stream_proto_rawDescOnce.Do(func() {
+		file_session_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_session_stream_proto_rawDescData)
+	})
+	return file_session_stream_proto_rawDescData
+}
+
+var file_session_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_session_stream_proto_goTypes = []interface{}{
+	(*SessionStreamFrame)(nil),             // 0: session.SessionStreamFrame
+	(*SessionStreamItem)(nil),              // 1: session.SessionStreamItem
+	(*SessionStreamGatewayData)(nil),       // 2: session.SessionStreamGatewayData
+	(*SessionStreamInvalidateAuthKey)(nil), // 3: session.SessionStreamInvalidateAuthKey
+	(*SessionClientEvent)(nil),             // 4: session.SessionClientEvent
+	(*SessionClientData)(nil),              // 5: session.SessionClientData
+}
+var file_session_stream_proto_depIdxs = []int32{
+	1, // 0: session.SessionStreamFrame.items:type_name -> session.SessionStreamItem
+	4, // 1: session.SessionStreamItem.create_session:type_name -> session.SessionClientEvent
+	5, // 2: session.SessionStreamItem.send_data:type_name -> session.SessionClientData
+	4, // 3: session.SessionStreamItem.close_session:type_name -> session.SessionClientEvent
+	2, // 4: session.SessionStreamItem.gateway_data:type_name -> session.SessionStreamGatewayData
+	3, // 5: session.SessionStreamItem.invalidate_auth_key:type_name -> session.SessionStreamInvalidateAuthKey
+	0, // 6: session.RPCSessionStream.session_stream:input_type -> session.SessionStreamFrame
+	0, // 7: session.RPCSessionStream.session_stream:output_type -> session.SessionStreamFrame
+	7, // [7:8] is the sub-list for method output_type
+	6, // [6:7] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_session_stream_proto_init() }
+func file_session_stream_proto_init() {
+	if File_session_stream_proto != nil {
+		return
+	}
+	file_session_tl_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_session_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStreamFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStreamItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStreamGatewayData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionStreamInvalidateAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_session_stream_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*SessionStreamItem_CreateSession)(nil),
+		(*SessionStreamItem_SendData)(nil),
+		(*SessionStreamItem_CloseSession)(nil),
+		(*SessionStreamItem_GatewayData)(nil),
+		(*SessionStreamItem_InvalidateAuthKey)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_session_stream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_session_stream_proto_goTypes,
+		DependencyIndexes: file_session_stream_proto_depIdxs,
+		MessageInfos:      file_session_stream_proto_msgTypes,
+	}.Build()
+	File_session_stream_proto = out.File
+	file_session_stream_proto_rawDesc = nil
+	file_session_stream_proto_goTypes = nil
+	file_session_stream_proto_depIdxs = nil
+}
@@ -0,0 +1,145 @@
+//
+// Copyright (c) 2024-present,  Teamgram Authors.
+//  All rights reserved.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v5.26.1
+// source: session.stream.proto
+
+package session
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RPCSessionStream_SessionStream_FullMethodName = "/session.RPCSessionStream/session_stream"
+)
+
+// RPCSessionStreamClient is the client API for RPCSessionStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RPCSessionStreamClient interface {
+	SessionStream(ctx context.Context, opts ...grpc.CallOption) (RPCSessionStream_SessionStreamClient, error)
+}
+
+type rPCSessionStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRPCSessionStreamClient(cc grpc.ClientConnInterface) RPCSessionStreamClient {
+	return &rPCSessionStreamClient{cc}
+}
+
+func (c *rPCSessionStreamClient) SessionStream(ctx context.Context, opts ...grpc.CallOption) (RPCSessionStream_SessionStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RPCSessionStream_ServiceDesc.Streams[0], RPCSessionStream_SessionStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rPCSessionStreamSessionStreamClient{stream}
+	return x, nil
+}
+
+type RPCSessionStream_SessionStreamClient interface {
+	Send(*SessionStreamFrame) error
+	Recv() (*SessionStreamFrame, error)
+	grpc.ClientStream
+}
+
+type rPCSessionStreamSessionStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *rPCSessionStreamSessionStreamClient) Send(m *SessionStreamFrame) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *rPCSessionStreamSessionStreamClient) Recv() (*SessionStreamFrame, error) {
+	m := new(SessionStreamFrame)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RPCSessionStreamServer is the server API for RPCSessionStream service.
+// All implementations should embed UnimplementedRPCSessionStreamServer
+// for forward compatibility
+type RPCSessionStreamServer interface {
+	SessionStream(RPCSessionStream_SessionStreamServer) error
+}
+
+// UnimplementedRPCSessionStreamServer should be embedded to have forward compatible implementations.
+type UnimplementedRPCSessionStreamServer struct {
+}
+
+func (UnimplementedRPCSessionStreamServer) SessionStream(RPCSessionStream_SessionStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method SessionStream not implemented")
+}
+
+// UnsafeRPCSessionStreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RPCSessionStreamServer will
+// result in compilation errors.
+type UnsafeRPCSessionStreamServer interface {
+	mustEmbedUnimplementedRPCSessionStreamServer()
+}
+
+func RegisterRPCSessionStreamServer(s grpc.ServiceRegistrar, srv RPCSessionStreamServer) {
+	s.RegisterService(&RPCSessionStream_ServiceDesc, srv)
+}
+
+func _RPCSessionStream_SessionStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RPCSessionStreamServer).SessionStream(&rPCSessionStreamSessionStreamServer{stream})
+}
+
+type RPCSessionStream_SessionStreamServer interface {
+	Send(*SessionStreamFrame) error
+	Recv() (*SessionStreamFrame, error)
+	grpc.ServerStream
+}
+
+type rPCSessionStreamSessionStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *rPCSessionStreamSessionStreamServer) Send(m *SessionStreamFrame) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *rPCSessionStreamSessionStreamServer) Recv() (*SessionStreamFrame, error) {
+	m := new(SessionStreamFrame)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RPCSessionStream_ServiceDesc is the grpc.ServiceDesc for RPCSessionStream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RPCSessionStream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "session.RPCSessionStream",
+	HandlerType: (*RPCSessionStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "session_stream",
+			Handler:       _RPCSessionStream_SessionStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "session.stream.proto",
+}
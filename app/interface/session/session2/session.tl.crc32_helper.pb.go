@@ -15,6 +15,7 @@ const (
 	CRC32_sessionClientEvent             TLConstructor = -243320993  // 0xf17f375f
 	CRC32_sessionClientData              TLConstructor = 1101139022  // 0x41a20c4e
 	CRC32_httpSessionData                TLConstructor = -606579889  // 0xdbd8534f
+	CRC32_sessionAuthKeyInfo             TLConstructor = 318045887   // 0x12f4febf
 	CRC32_session_queryAuthKey           TLConstructor = 1798174801  // 0x6b2df851
 	CRC32_session_setAuthKey             TLConstructor = 487672075   // 0x1d11490b
 	CRC32_session_createSession          TLConstructor = 1091351053  // 0x410cb20d
@@ -24,4 +25,7 @@ const (
 	CRC32_session_pushUpdatesData        TLConstructor = -1519069143 // 0xa574d829
 	CRC32_session_pushSessionUpdatesData TLConstructor = 1173618080  // 0x45f3fda0
 	CRC32_session_pushRpcResultData      TLConstructor = 1262947465  // 0x4b470c89
+	CRC32_session_invalidateAuthKey      TLConstructor = -860456465  // 0xccb679ef
+	CRC32_session_bindTempAuthKey        TLConstructor = -1601034388 // 0xa092276c
+	CRC32_session_queryAuthKeyInfo       TLConstructor = 701517553   // 0x29d04ef1
 )
@@ -9,7 +9,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.33.0
+// 	protoc-gen-go v1.34.2
 // 	protoc        v5.26.1
 // source: session.tl.proto
 
@@ -38,6 +38,7 @@ const (
 	TLConstructor_CRC32_sessionClientEvent             TLConstructor = -243320993
 	TLConstructor_CRC32_sessionClientData              TLConstructor = 1101139022
 	TLConstructor_CRC32_httpSessionData                TLConstructor = -606579889
+	TLConstructor_CRC32_sessionAuthKeyInfo             TLConstructor = 318045887
 	TLConstructor_CRC32_session_queryAuthKey           TLConstructor = 1798174801
 	TLConstructor_CRC32_session_setAuthKey             TLConstructor = 487672075
 	TLConstructor_CRC32_session_createSession          TLConstructor = 1091351053
@@ -47,6 +48,9 @@ const (
 	TLConstructor_CRC32_session_pushUpdatesData        TLConstructor = -1519069143
 	TLConstructor_CRC32_session_pushSessionUpdatesData TLConstructor = 1173618080
 	TLConstructor_CRC32_session_pushRpcResultData      TLConstructor = 1262947465
+	TLConstructor_CRC32_session_invalidateAuthKey      TLConstructor = -860456465
+	TLConstructor_CRC32_session_bindTempAuthKey        TLConstructor = -1601034388
+	TLConstructor_CRC32_session_queryAuthKeyInfo       TLConstructor = 701517553
 )
 
 // Enum value maps for TLConstructor.
@@ -56,6 +60,7 @@ var (
 		-243320993:  "CRC32_sessionClientEvent",
 		1101139022:  "CRC32_sessionClientData",
 		-606579889:  "CRC32_httpSessionData",
+		318045887:   "CRC32_sessionAuthKeyInfo",
 		1798174801:  "CRC32_session_queryAuthKey",
 		487672075:   "CRC32_session_setAuthKey",
 		1091351053:  "CRC32_session_createSession",
@@ -65,12 +70,16 @@ var (
 		-1519069143: "CRC32_session_pushUpdatesData",
 		1173618080:  "CRC32_session_pushSessionUpdatesData",
 		1262947465:  "CRC32_session_pushRpcResultData",
+		-860456465:  "CRC32_session_invalidateAuthKey",
+		-1601034388: "CRC32_session_bindTempAuthKey",
+		701517553:   "CRC32_session_queryAuthKeyInfo",
 	}
 	TLConstructor_value = map[string]int32{
 		"CRC32_UNKNOWN":                        0,
 		"CRC32_sessionClientEvent":             -243320993,
 		"CRC32_sessionClientData":              1101139022,
 		"CRC32_httpSessionData":                -606579889,
+		"CRC32_sessionAuthKeyInfo":             318045887,
 		"CRC32_session_queryAuthKey":           1798174801,
 		"CRC32_session_setAuthKey":             487672075,
 		"CRC32_session_createSession":          1091351053,
@@ -80,6 +89,9 @@ var (
 		"CRC32_session_pushUpdatesData":        -1519069143,
 		"CRC32_session_pushSessionUpdatesData": 1173618080,
 		"CRC32_session_pushRpcResultData":      1262947465,
+		"CRC32_session_invalidateAuthKey":      -860456465,
+		"CRC32_session_bindTempAuthKey":        -1601034388,
+		"CRC32_session_queryAuthKeyInfo":       701517553,
 	}
 )
 
@@ -222,6 +234,126 @@ func (x *TLHttpSessionData) GetData2() *HttpSessionData {
 	return nil
 }
 
+// SessionAuthKeyInfo <--
+//   - TL_sessionAuthKeyInfo
+type SessionAuthKeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PredicateName string               `protobuf:"bytes,1,opt,name=predicate_name,json=predicateName,proto3" json:"predicate_name,omitempty"`
+	Constructor   TLConstructor        `protobuf:"varint,2,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	AuthKey       *mtproto.AuthKeyInfo `protobuf:"bytes,3,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
+	ExpiresAt     int64                `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *SessionAuthKeyInfo) Reset() {
+	*x = SessionAuthKeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_tl_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionAuthKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionAuthKeyInfo) ProtoMessage() {}
+
+func (x *SessionAuthKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_session_tl_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionAuthKeyInfo.ProtoReflect.Descriptor instead.
+func (*SessionAuthKeyInfo) Descriptor() ([]byte, []int) {
+	return file_session_tl_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SessionAuthKeyInfo) GetPredicateName() string {
+	if x != nil {
+		return x.PredicateName
+	}
+	return ""
+}
+
+func (x *SessionAuthKeyInfo) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *SessionAuthKeyInfo) GetAuthKey() *mtproto.AuthKeyInfo {
+	if x != nil {
+		return x.AuthKey
+	}
+	return nil
+}
+
+func (x *SessionAuthKeyInfo) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type TLSessionAuthKeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data2 *SessionAuthKeyInfo `protobuf:"bytes,1,opt,name=data2,proto3" json:"data2,omitempty"`
+}
+
+func (x *TLSessionAuthKeyInfo) Reset() {
+	*x = TLSessionAuthKeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_tl_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSessionAuthKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSessionAuthKeyInfo) ProtoMessage() {}
+
+func (x *TLSessionAuthKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_session_tl_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSessionAuthKeyInfo.ProtoReflect.Descriptor instead.
+func (*TLSessionAuthKeyInfo) Descriptor() ([]byte, []int) {
+	return file_session_tl_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TLSessionAuthKeyInfo) GetData2() *SessionAuthKeyInfo {
+	if x != nil {
+		return x.Data2
+	}
+	return nil
+}
+
 // SessionClientData <--
 //   - TL_sessionClientData
 type SessionClientData struct {
@@ -246,7 +378,7 @@ type SessionClientData struct {
 func (x *SessionClientData) Reset() {
 	*x = SessionClientData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[2]
+		mi := &file_session_tl_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -259,7 +391,7 @@ func (x *SessionClientData) String() string {
 func (*SessionClientData) ProtoMessage() {}
 
 func (x *SessionClientData) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[2]
+	mi := &file_session_tl_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -272,7 +404,7 @@ func (x *SessionClientData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionClientData.ProtoReflect.Descriptor instead.
 func (*SessionClientData) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{2}
+	return file_session_tl_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SessionClientData) GetPredicateName() string {
@@ -370,7 +502,7 @@ type TLSessionClientData struct {
 func (x *TLSessionClientData) Reset() {
 	*x = TLSessionClientData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[3]
+		mi := &file_session_tl_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -383,7 +515,7 @@ func (x *TLSessionClientData) String() string {
 func (*TLSessionClientData) ProtoMessage() {}
 
 func (x *TLSessionClientData) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[3]
+	mi := &file_session_tl_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -396,7 +528,7 @@ func (x *TLSessionClientData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionClientData.ProtoReflect.Descriptor instead.
 func (*TLSessionClientData) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{3}
+	return file_session_tl_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *TLSessionClientData) GetData2() *SessionClientData {
@@ -427,7 +559,7 @@ type SessionClientEvent struct {
 func (x *SessionClientEvent) Reset() {
 	*x = SessionClientEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[4]
+		mi := &file_session_tl_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +572,7 @@ func (x *SessionClientEvent) String() string {
 func (*SessionClientEvent) ProtoMessage() {}
 
 func (x *SessionClientEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[4]
+	mi := &file_session_tl_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +585,7 @@ func (x *SessionClientEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SessionClientEvent.ProtoReflect.Descriptor instead.
 func (*SessionClientEvent) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{4}
+	return file_session_tl_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SessionClientEvent) GetPredicateName() string {
@@ -530,7 +662,7 @@ type TLSessionClientEvent struct {
 func (x *TLSessionClientEvent) Reset() {
 	*x = TLSessionClientEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[5]
+		mi := &file_session_tl_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -543,7 +675,7 @@ func (x *TLSessionClientEvent) String() string {
 func (*TLSessionClientEvent) ProtoMessage() {}
 
 func (x *TLSessionClientEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[5]
+	mi := &file_session_tl_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +688,7 @@ func (x *TLSessionClientEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionClientEvent.ProtoReflect.Descriptor instead.
 func (*TLSessionClientEvent) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{5}
+	return file_session_tl_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TLSessionClientEvent) GetData2() *SessionClientEvent {
@@ -579,7 +711,7 @@ type TLSessionQueryAuthKey struct {
 func (x *TLSessionQueryAuthKey) Reset() {
 	*x = TLSessionQueryAuthKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[6]
+		mi := &file_session_tl_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -592,7 +724,7 @@ func (x *TLSessionQueryAuthKey) String() string {
 func (*TLSessionQueryAuthKey) ProtoMessage() {}
 
 func (x *TLSessionQueryAuthKey) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[6]
+	mi := &file_session_tl_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +737,7 @@ func (x *TLSessionQueryAuthKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionQueryAuthKey.ProtoReflect.Descriptor instead.
 func (*TLSessionQueryAuthKey) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{6}
+	return file_session_tl_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *TLSessionQueryAuthKey) GetConstructor() TLConstructor {
@@ -637,7 +769,7 @@ type TLSessionSetAuthKey struct {
 func (x *TLSessionSetAuthKey) Reset() {
 	*x = TLSessionSetAuthKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[7]
+		mi := &file_session_tl_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -650,7 +782,7 @@ func (x *TLSessionSetAuthKey) String() string {
 func (*TLSessionSetAuthKey) ProtoMessage() {}
 
 func (x *TLSessionSetAuthKey) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[7]
+	mi := &file_session_tl_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,7 +795,7 @@ func (x *TLSessionSetAuthKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionSetAuthKey.ProtoReflect.Descriptor instead.
 func (*TLSessionSetAuthKey) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{7}
+	return file_session_tl_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TLSessionSetAuthKey) GetConstructor() TLConstructor {
@@ -707,7 +839,7 @@ type TLSessionCreateSession struct {
 func (x *TLSessionCreateSession) Reset() {
 	*x = TLSessionCreateSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[8]
+		mi := &file_session_tl_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -720,7 +852,7 @@ func (x *TLSessionCreateSession) String() string {
 func (*TLSessionCreateSession) ProtoMessage() {}
 
 func (x *TLSessionCreateSession) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[8]
+	mi := &file_session_tl_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +865,7 @@ func (x *TLSessionCreateSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionCreateSession.ProtoReflect.Descriptor instead.
 func (*TLSessionCreateSession) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{8}
+	return file_session_tl_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TLSessionCreateSession) GetConstructor() TLConstructor {
@@ -763,7 +895,7 @@ type TLSessionSendDataToSession struct {
 func (x *TLSessionSendDataToSession) Reset() {
 	*x = TLSessionSendDataToSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[9]
+		mi := &file_session_tl_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +908,7 @@ func (x *TLSessionSendDataToSession) String() string {
 func (*TLSessionSendDataToSession) ProtoMessage() {}
 
 func (x *TLSessionSendDataToSession) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[9]
+	mi := &file_session_tl_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +921,7 @@ func (x *TLSessionSendDataToSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionSendDataToSession.ProtoReflect.Descriptor instead.
 func (*TLSessionSendDataToSession) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{9}
+	return file_session_tl_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *TLSessionSendDataToSession) GetConstructor() TLConstructor {
@@ -819,7 +951,7 @@ type TLSessionSendHttpDataToSession struct {
 func (x *TLSessionSendHttpDataToSession) Reset() {
 	*x = TLSessionSendHttpDataToSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[10]
+		mi := &file_session_tl_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -832,7 +964,7 @@ func (x *TLSessionSendHttpDataToSession) String() string {
 func (*TLSessionSendHttpDataToSession) ProtoMessage() {}
 
 func (x *TLSessionSendHttpDataToSession) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[10]
+	mi := &file_session_tl_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -845,7 +977,7 @@ func (x *TLSessionSendHttpDataToSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionSendHttpDataToSession.ProtoReflect.Descriptor instead.
 func (*TLSessionSendHttpDataToSession) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{10}
+	return file_session_tl_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TLSessionSendHttpDataToSession) GetConstructor() TLConstructor {
@@ -875,7 +1007,7 @@ type TLSessionCloseSession struct {
 func (x *TLSessionCloseSession) Reset() {
 	*x = TLSessionCloseSession{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[11]
+		mi := &file_session_tl_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -888,7 +1020,7 @@ func (x *TLSessionCloseSession) String() string {
 func (*TLSessionCloseSession) ProtoMessage() {}
 
 func (x *TLSessionCloseSession) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[11]
+	mi := &file_session_tl_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,7 +1033,7 @@ func (x *TLSessionCloseSession) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionCloseSession.ProtoReflect.Descriptor instead.
 func (*TLSessionCloseSession) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{11}
+	return file_session_tl_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *TLSessionCloseSession) GetConstructor() TLConstructor {
@@ -933,7 +1065,7 @@ type TLSessionPushUpdatesData struct {
 func (x *TLSessionPushUpdatesData) Reset() {
 	*x = TLSessionPushUpdatesData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[12]
+		mi := &file_session_tl_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -946,7 +1078,7 @@ func (x *TLSessionPushUpdatesData) String() string {
 func (*TLSessionPushUpdatesData) ProtoMessage() {}
 
 func (x *TLSessionPushUpdatesData) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[12]
+	mi := &file_session_tl_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -959,7 +1091,7 @@ func (x *TLSessionPushUpdatesData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionPushUpdatesData.ProtoReflect.Descriptor instead.
 func (*TLSessionPushUpdatesData) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{12}
+	return file_session_tl_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TLSessionPushUpdatesData) GetConstructor() TLConstructor {
@@ -1006,7 +1138,7 @@ type TLSessionPushSessionUpdatesData struct {
 func (x *TLSessionPushSessionUpdatesData) Reset() {
 	*x = TLSessionPushSessionUpdatesData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[13]
+		mi := &file_session_tl_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1019,7 +1151,7 @@ func (x *TLSessionPushSessionUpdatesData) String() string {
 func (*TLSessionPushSessionUpdatesData) ProtoMessage() {}
 
 func (x *TLSessionPushSessionUpdatesData) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[13]
+	mi := &file_session_tl_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1164,7 @@ func (x *TLSessionPushSessionUpdatesData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionPushSessionUpdatesData.ProtoReflect.Descriptor instead.
 func (*TLSessionPushSessionUpdatesData) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{13}
+	return file_session_tl_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TLSessionPushSessionUpdatesData) GetConstructor() TLConstructor {
@@ -1087,7 +1219,7 @@ type TLSessionPushRpcResultData struct {
 func (x *TLSessionPushRpcResultData) Reset() {
 	*x = TLSessionPushRpcResultData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_session_tl_proto_msgTypes[14]
+		mi := &file_session_tl_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1100,7 +1232,7 @@ func (x *TLSessionPushRpcResultData) String() string {
 func (*TLSessionPushRpcResultData) ProtoMessage() {}
 
 func (x *TLSessionPushRpcResultData) ProtoReflect() protoreflect.Message {
-	mi := &file_session_tl_proto_msgTypes[14]
+	mi := &file_session_tl_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,7 +1245,7 @@ func (x *TLSessionPushRpcResultData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TLSessionPushRpcResultData.ProtoReflect.Descriptor instead.
 func (*TLSessionPushRpcResultData) Descriptor() ([]byte, []int) {
-	return file_session_tl_proto_rawDescGZIP(), []int{14}
+	return file_session_tl_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TLSessionPushRpcResultData) GetConstructor() TLConstructor {
@@ -1158,6 +1290,198 @@ func (x *TLSessionPushRpcResultData) GetRpcResultData() []byte {
 	return nil
 }
 
+// --------------------------------------------------------------------------------------------
+type TLSessionInvalidateAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId   int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+	Destroyed   bool          `protobuf:"varint,4,opt,name=destroyed,proto3" json:"destroyed,omitempty"`
+}
+
+func (x *TLSessionInvalidateAuthKey) Reset() {
+	*x = TLSessionInvalidateAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_tl_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSessionInvalidateAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSessionInvalidateAuthKey) ProtoMessage() {}
+
+func (x *TLSessionInvalidateAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_session_tl_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSessionInvalidateAuthKey.ProtoReflect.Descriptor instead.
+func (*TLSessionInvalidateAuthKey) Descriptor() ([]byte, []int) {
+	return file_session_tl_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TLSessionInvalidateAuthKey) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLSessionInvalidateAuthKey) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
+func (x *TLSessionInvalidateAuthKey) GetDestroyed() bool {
+	if x != nil {
+		return x.Destroyed
+	}
+	return false
+}
+
+// --------------------------------------------------------------------------------------------
+type TLSessionBindTempAuthKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor   TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	PermAuthKeyId int64         `protobuf:"varint,3,opt,name=perm_auth_key_id,json=permAuthKeyId,proto3" json:"perm_auth_key_id,omitempty"`
+	TempAuthKeyId int64         `protobuf:"varint,4,opt,name=temp_auth_key_id,json=tempAuthKeyId,proto3" json:"temp_auth_key_id,omitempty"`
+	ExpiresAt     int64         `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *TLSessionBindTempAuthKey) Reset() {
+	*x = TLSessionBindTempAuthKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_tl_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSessionBindTempAuthKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSessionBindTempAuthKey) ProtoMessage() {}
+
+func (x *TLSessionBindTempAuthKey) ProtoReflect() protoreflect.Message {
+	mi := &file_session_tl_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSessionBindTempAuthKey.ProtoReflect.Descriptor instead.
+func (*TLSessionBindTempAuthKey) Descriptor() ([]byte, []int) {
+	return file_session_tl_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TLSessionBindTempAuthKey) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLSessionBindTempAuthKey) GetPermAuthKeyId() int64 {
+	if x != nil {
+		return x.PermAuthKeyId
+	}
+	return 0
+}
+
+func (x *TLSessionBindTempAuthKey) GetTempAuthKeyId() int64 {
+	if x != nil {
+		return x.TempAuthKeyId
+	}
+	return 0
+}
+
+func (x *TLSessionBindTempAuthKey) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// --------------------------------------------------------------------------------------------
+type TLSessionQueryAuthKeyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Constructor TLConstructor `protobuf:"varint,1,opt,name=constructor,proto3,enum=session.TLConstructor" json:"constructor,omitempty"`
+	AuthKeyId   int64         `protobuf:"varint,3,opt,name=auth_key_id,json=authKeyId,proto3" json:"auth_key_id,omitempty"`
+}
+
+func (x *TLSessionQueryAuthKeyInfo) Reset() {
+	*x = TLSessionQueryAuthKeyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_session_tl_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLSessionQueryAuthKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLSessionQueryAuthKeyInfo) ProtoMessage() {}
+
+func (x *TLSessionQueryAuthKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_session_tl_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLSessionQueryAuthKeyInfo.ProtoReflect.Descriptor instead.
+func (*TLSessionQueryAuthKeyInfo) Descriptor() ([]byte, []int) {
+	return file_session_tl_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TLSessionQueryAuthKeyInfo) GetConstructor() TLConstructor {
+	if x != nil {
+		return x.Constructor
+	}
+	return TLConstructor_CRC32_UNKNOWN
+}
+
+func (x *TLSessionQueryAuthKeyInfo) GetAuthKeyId() int64 {
+	if x != nil {
+		return x.AuthKeyId
+	}
+	return 0
+}
+
 var File_session_tl_proto protoreflect.FileDescriptor
 
 var file_session_tl_proto_rawDesc = []byte{
@@ -1182,166 +1506,213 @@ var file_session_tl_proto_rawDesc = []byte{
 	0x74, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a,
 	0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
 	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0x99, 0x03,
-	0x0a, 0x11, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65,
-	0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0xc5, 0x01,
+	0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61,
+	0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x4a, 0x0a, 0x15, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x31,
+	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
+	0x32, 0x22, 0x99, 0x03, 0x0a, 0x11, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
+	0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a,
+	0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x6b, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x41, 0x63, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x61, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x48, 0x0a,
+	0x14, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0xcf, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6b, 0x65, 0x79,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x4a, 0x0a, 0x15, 0x54, 0x4c, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05,
+	0x64, 0x61, 0x74, 0x61, 0x32, 0x22, 0x73, 0x0a, 0x17, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
+	0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x54,
+	0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f,
+	0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x34, 0x0a, 0x0b, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x61, 0x6c, 0x74, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72,
+	0x65, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x49, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x18, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x22, 0x88, 0x01, 0x0a, 0x1c, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x01, 0x0a, 0x20,
+	0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x48,
+	0x74, 0x74, 0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
+	0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x88,
+	0x01, 0x0a, 0x17, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
 	0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73,
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e,
-	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x19,
-	0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72,
-	0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
-	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x70, 0x12, 0x1b,
-	0x0a, 0x09, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x71, 0x75, 0x69, 0x63, 0x6b, 0x41, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x61, 0x6c, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x48, 0x0a, 0x14, 0x54, 0x4c, 0x5f,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x64, 0x61,
-	0x74, 0x61, 0x32, 0x22, 0xcf, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
-	0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x1a, 0x54, 0x4c,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65,
+	0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x21,
+	0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
 	0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x6f, 0x6e,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
-	0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65,
-	0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d,
-	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x70, 0x22, 0x4a, 0x0a, 0x15, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x31,
-	0x0a, 0x05, 0x64, 0x61, 0x74, 0x61, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x64, 0x61, 0x74, 0x61,
-	0x32, 0x22, 0x73, 0x0a, 0x17, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b,
-	0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x54, 0x4c, 0x5f, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
+	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x70,
+	0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22,
+	0x93, 0x02, 0x0a, 0x1c, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x75, 0x73, 0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61,
 	0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
 	0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x61, 0x75,
-	0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x66,
-	0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72,
-	0x65, 0x53, 0x61, 0x6c, 0x74, 0x52, 0x0a, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x53, 0x61, 0x6c,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e,
-	0x22, 0x89, 0x01, 0x0a, 0x18, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a,
-	0x1c, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x54, 0x4c, 0x5f, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x74, 0x74, 0x70, 0x44,
-	0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x54,
-	0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65,
+	0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65,
+	0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71,
+	0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x72, 0x70, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x72, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x22, 0x96, 0x01, 0x0a, 0x1c, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72,
 	0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
 	0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72,
-	0x12, 0x33, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x1a, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
-	0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27,
-	0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75,
-	0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x07, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x21, 0x54, 0x4c, 0x5f, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x65, 0x64, 0x22, 0xc7,
+	0x01, 0x0a, 0x1a, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x69,
+	0x6e, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a,
 	0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43,
 	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
 	0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f,
 	0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64,
-	0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x2a, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x73, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x1c,
-	0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x52,
-	0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x70, 0x65, 0x72, 0x6d, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
-	0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29,
-	0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x6d, 0x73, 0x67,
-	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x70, 0x63,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0d, 0x72, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x2a, 0x80, 0x04, 0x0a, 0x0d, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x12, 0x27, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x74, 0x65, 0x6d, 0x70,
+	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x77, 0x0a, 0x1b, 0x54, 0x4c, 0x5f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x2a, 0xa4, 0x05, 0x0a, 0x0d, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
 	0x74, 0x6f, 0x72, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x55, 0x4e, 0x4b,
 	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x18, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f,
 	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65,
@@ -1350,83 +1721,109 @@ var file_session_tl_proto_rawDesc = []byte{
 	0x69, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x10, 0xce, 0x98, 0x88, 0x8d, 0x04, 0x12, 0x22,
 	0x0a, 0x15, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x53, 0x65, 0x73, 0x73,
 	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x10, 0xcf, 0xa6, 0xe1, 0xde, 0xfd, 0xff, 0xff, 0xff,
-	0xff, 0x01, 0x12, 0x22, 0x0a, 0x1a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79,
-	0x10, 0xd1, 0xf0, 0xb7, 0xd9, 0x06, 0x12, 0x20, 0x0a, 0x18, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b,
-	0x65, 0x79, 0x10, 0x8b, 0x92, 0xc5, 0xe8, 0x01, 0x12, 0x23, 0x0a, 0x1b, 0x43, 0x52, 0x43, 0x33,
-	0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x8d, 0xe4, 0xb2, 0x88, 0x04, 0x12, 0x2c, 0x0a,
-	0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
-	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x10, 0xec, 0xdb, 0xac, 0xbb, 0xf8, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x30, 0x0a, 0x23, 0x43,
-	0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e,
-	0x64, 0x48, 0x74, 0x74, 0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x10, 0xae, 0xc7, 0xb0, 0xdf, 0xfb, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x22, 0x0a,
-	0x1a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
-	0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0xd3, 0x84, 0xbf, 0xbb,
-	0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61,
-	0x74, 0x61, 0x10, 0xa9, 0xb0, 0xd3, 0xab, 0xfa, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x2c, 0x0a,
-	0x24, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
-	0x75, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x73, 0x44, 0x61, 0x74, 0x61, 0x10, 0xa0, 0xfb, 0xcf, 0xaf, 0x04, 0x12, 0x27, 0x0a, 0x1f, 0x43,
-	0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73,
-	0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x10, 0x89,
-	0x99, 0x9c, 0xda, 0x04, 0x32, 0xff, 0x05, 0x0a, 0x0a, 0x52, 0x50, 0x43, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x2e, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a, 0x14, 0x2e,
-	0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49,
-	0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x2e, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a, 0x0d, 0x2e, 0x6d, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x15,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e,
-	0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64,
-	0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e,
-	0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x66,
-	0x0a, 0x1d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x74,
-	0x74, 0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x29, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x74, 0x74, 0x70, 0x44, 0x61, 0x74,
-	0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20,
-	0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x17, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73,
-	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x23, 0x2e, 0x73,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x1e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75,
+	0xff, 0x01, 0x12, 0x20, 0x0a, 0x18, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0xbf,
+	0xfd, 0xd3, 0x97, 0x01, 0x12, 0x22, 0x0a, 0x1a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b,
+	0x65, 0x79, 0x10, 0xd1, 0xf0, 0xb7, 0xd9, 0x06, 0x12, 0x20, 0x0a, 0x18, 0x43, 0x52, 0x43, 0x33,
+	0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74,
+	0x68, 0x4b, 0x65, 0x79, 0x10, 0x8b, 0x92, 0xc5, 0xe8, 0x01, 0x12, 0x23, 0x0a, 0x1b, 0x43, 0x52,
+	0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x8d, 0xe4, 0xb2, 0x88, 0x04, 0x12,
+	0x2c, 0x0a, 0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x10, 0xec, 0xdb, 0xac, 0xbb, 0xf8, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12, 0x30, 0x0a,
+	0x23, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73,
+	0x65, 0x6e, 0x64, 0x48, 0x74, 0x74, 0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x10, 0xae, 0xc7, 0xb0, 0xdf, 0xfb, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12,
+	0x22, 0x0a, 0x1a, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0xd3, 0x84,
+	0xbf, 0xbb, 0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x44, 0x61, 0x74, 0x61, 0x10, 0xa9, 0xb0, 0xd3, 0xab, 0xfa, 0xff, 0xff, 0xff, 0xff, 0x01, 0x12,
+	0x2c, 0x0a, 0x24, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x10, 0xa0, 0xfb, 0xcf, 0xaf, 0x04, 0x12, 0x27, 0x0a,
+	0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
+	0x75, 0x73, 0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x10, 0x89, 0x99, 0x9c, 0xda, 0x04, 0x12, 0x2c, 0x0a, 0x1f, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x10, 0xef, 0xf3, 0xd9, 0xe5, 0xfc, 0xff,
+	0xff, 0xff, 0xff, 0x01, 0x12, 0x2a, 0x0a, 0x1d, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x41, 0x75,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x10, 0xec, 0xce, 0xc8, 0x84, 0xfa, 0xff, 0xff, 0xff, 0xff, 0x01,
+	0x12, 0x26, 0x0a, 0x1e, 0x43, 0x52, 0x43, 0x33, 0x32, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x10, 0xf1, 0x9d, 0xc1, 0xce, 0x02, 0x32, 0x86, 0x08, 0x0a, 0x0a, 0x52, 0x50, 0x43,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x50, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x20, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65,
+	0x79, 0x1a, 0x14, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x12, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12,
+	0x1e, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00,
+	0x12, 0x4b, 0x0a, 0x15, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x53, 0x0a,
+	0x19, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x22, 0x00, 0x12, 0x66, 0x0a, 0x1d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65,
+	0x6e, 0x64, 0x48, 0x74, 0x74, 0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c,
+	0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x48, 0x74, 0x74,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x18,
+	0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x14, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x17, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x23, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x1e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75,
 	0x73, 0x68, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54,
-	0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
-	0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x19, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73,
-	0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x25,
-	0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x65,
-	0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70,
-	0x70, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x70, 0x75, 0x73, 0x68, 0x52, 0x70, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x52, 0x70, 0x63, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a, 0x0d,
+	0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x17, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x54,
+	0x65, 0x6d, 0x70, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x2e, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x62, 0x69, 0x6e, 0x64, 0x54, 0x65, 0x6d, 0x70, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x1a,
+	0x0d, 0x2e, 0x6d, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x22, 0x00,
+	0x12, 0x5f, 0x0a, 0x18, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x4c, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x66, 0x6f, 0x1a, 0x1b, 0x2e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x22,
+	0x00, 0x42, 0x43, 0x5a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61, 0x6d, 0x2f, 0x74, 0x65, 0x61, 0x6d, 0x67, 0x72, 0x61,
+	0x6d, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1442,76 +1839,93 @@ func file_session_tl_proto_rawDescGZIP() []byte {
 }
 
 var file_session_tl_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_session_tl_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_session_tl_proto_goTypes = []interface{}{
+var file_session_tl_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_session_tl_proto_goTypes = []any{
 	(TLConstructor)(0),                      // 0: session.TLConstructor
 	(*HttpSessionData)(nil),                 // 1: session.HttpSessionData
 	(*TLHttpSessionData)(nil),               // 2: session.TL_httpSessionData
-	(*SessionClientData)(nil),               // 3: session.SessionClientData
-	(*TLSessionClientData)(nil),             // 4: session.TL_sessionClientData
-	(*SessionClientEvent)(nil),              // 5: session.SessionClientEvent
-	(*TLSessionClientEvent)(nil),            // 6: session.TL_sessionClientEvent
-	(*TLSessionQueryAuthKey)(nil),           // 7: session.TL_session_queryAuthKey
-	(*TLSessionSetAuthKey)(nil),             // 8: session.TL_session_setAuthKey
-	(*TLSessionCreateSession)(nil),          // 9: session.TL_session_createSession
-	(*TLSessionSendDataToSession)(nil),      // 10: session.TL_session_sendDataToSession
-	(*TLSessionSendHttpDataToSession)(nil),  // 11: session.TL_session_sendHttpDataToSession
-	(*TLSessionCloseSession)(nil),           // 12: session.TL_session_closeSession
-	(*TLSessionPushUpdatesData)(nil),        // 13: session.TL_session_pushUpdatesData
-	(*TLSessionPushSessionUpdatesData)(nil), // 14: session.TL_session_pushSessionUpdatesData
-	(*TLSessionPushRpcResultData)(nil),      // 15: session.TL_session_pushRpcResultData
-	(*mtproto.AuthKeyInfo)(nil),             // 16: mtproto.AuthKeyInfo
-	(*mtproto.FutureSalt)(nil),              // 17: mtproto.FutureSalt
-	(*mtproto.Updates)(nil),                 // 18: mtproto.Updates
-	(*mtproto.Bool)(nil),                    // 19: mtproto.Bool
+	(*SessionAuthKeyInfo)(nil),              // 3: session.SessionAuthKeyInfo
+	(*TLSessionAuthKeyInfo)(nil),            // 4: session.TL_sessionAuthKeyInfo
+	(*SessionClientData)(nil),               // 5: session.SessionClientData
+	(*TLSessionClientData)(nil),             // 6: session.TL_sessionClientData
+	(*SessionClientEvent)(nil),              // 7: session.SessionClientEvent
+	(*TLSessionClientEvent)(nil),            // 8: session.TL_sessionClientEvent
+	(*TLSessionQueryAuthKey)(nil),           // 9: session.TL_session_queryAuthKey
+	(*TLSessionSetAuthKey)(nil),             // 10: session.TL_session_setAuthKey
+	(*TLSessionCreateSession)(nil),          // 11: session.TL_session_createSession
+	(*TLSessionSendDataToSession)(nil),      // 12: session.TL_session_sendDataToSession
+	(*TLSessionSendHttpDataToSession)(nil),  // 13: session.TL_session_sendHttpDataToSession
+	(*TLSessionCloseSession)(nil),           // 14: session.TL_session_closeSession
+	(*TLSessionPushUpdatesData)(nil),        // 15: session.TL_session_pushUpdatesData
+	(*TLSessionPushSessionUpdatesData)(nil), // 16: session.TL_session_pushSessionUpdatesData
+	(*TLSessionPushRpcResultData)(nil),      // 17: session.TL_session_pushRpcResultData
+	(*TLSessionInvalidateAuthKey)(nil),      // 18: session.TL_session_invalidateAuthKey
+	(*TLSessionBindTempAuthKey)(nil),        // 19: session.TL_session_bindTempAuthKey
+	(*TLSessionQueryAuthKeyInfo)(nil),       // 20: session.TL_session_queryAuthKeyInfo
+	(*mtproto.AuthKeyInfo)(nil),             // 21: mtproto.AuthKeyInfo
+	(*mtproto.FutureSalt)(nil),              // 22: mtproto.FutureSalt
+	(*mtproto.Updates)(nil),                 // 23: mtproto.Updates
+	(*mtproto.Bool)(nil),                    // 24: mtproto.Bool
 }
 var file_session_tl_proto_depIdxs = []int32{
 	0,  // 0: session.HttpSessionData.constructor:type_name -> session.TLConstructor
 	1,  // 1: session.TL_httpSessionData.data2:type_name -> session.HttpSessionData
-	0,  // 2: session.SessionClientData.constructor:type_name -> session.TLConstructor
-	3,  // 3: session.TL_sessionClientData.data2:type_name -> session.SessionClientData
-	0,  // 4: session.SessionClientEvent.constructor:type_name -> session.TLConstructor
-	5,  // 5: session.TL_sessionClientEvent.data2:type_name -> session.SessionClientEvent
-	0,  // 6: session.TL_session_queryAuthKey.constructor:type_name -> session.TLConstructor
-	0,  // 7: session.TL_session_setAuthKey.constructor:type_name -> session.TLConstructor
-	16, // 8: session.TL_session_setAuthKey.auth_key:type_name -> mtproto.AuthKeyInfo
-	17, // 9: session.TL_session_setAuthKey.future_salt:type_name -> mtproto.FutureSalt
-	0,  // 10: session.TL_session_createSession.constructor:type_name -> session.TLConstructor
-	5,  // 11: session.TL_session_createSession.client:type_name -> session.SessionClientEvent
-	0,  // 12: session.TL_session_sendDataToSession.constructor:type_name -> session.TLConstructor
-	3,  // 13: session.TL_session_sendDataToSession.data:type_name -> session.SessionClientData
-	0,  // 14: session.TL_session_sendHttpDataToSession.constructor:type_name -> session.TLConstructor
-	3,  // 15: session.TL_session_sendHttpDataToSession.client:type_name -> session.SessionClientData
-	0,  // 16: session.TL_session_closeSession.constructor:type_name -> session.TLConstructor
-	5,  // 17: session.TL_session_closeSession.client:type_name -> session.SessionClientEvent
-	0,  // 18: session.TL_session_pushUpdatesData.constructor:type_name -> session.TLConstructor
-	18, // 19: session.TL_session_pushUpdatesData.updates:type_name -> mtproto.Updates
-	0,  // 20: session.TL_session_pushSessionUpdatesData.constructor:type_name -> session.TLConstructor
-	18, // 21: session.TL_session_pushSessionUpdatesData.updates:type_name -> mtproto.Updates
-	0,  // 22: session.TL_session_pushRpcResultData.constructor:type_name -> session.TLConstructor
-	7,  // 23: session.RPCSession.session_queryAuthKey:input_type -> session.TL_session_queryAuthKey
-	8,  // 24: session.RPCSession.session_setAuthKey:input_type -> session.TL_session_setAuthKey
-	9,  // 25: session.RPCSession.session_createSession:input_type -> session.TL_session_createSession
-	10, // 26: session.RPCSession.session_sendDataToSession:input_type -> session.TL_session_sendDataToSession
-	11, // 27: session.RPCSession.session_sendHttpDataToSession:input_type -> session.TL_session_sendHttpDataToSession
-	12, // 28: session.RPCSession.session_closeSession:input_type -> session.TL_session_closeSession
-	13, // 29: session.RPCSession.session_pushUpdatesData:input_type -> session.TL_session_pushUpdatesData
-	14, // 30: session.RPCSession.session_pushSessionUpdatesData:input_type -> session.TL_session_pushSessionUpdatesData
-	15, // 31: session.RPCSession.session_pushRpcResultData:input_type -> session.TL_session_pushRpcResultData
-	16, // 32: session.RPCSession.session_queryAuthKey:output_type -> mtproto.AuthKeyInfo
-	19, // 33: session.RPCSession.session_setAuthKey:output_type -> mtproto.Bool
-	19, // 34: session.RPCSession.session_createSession:output_type -> mtproto.Bool
-	19, // 35: session.RPCSession.session_sendDataToSession:output_type -> mtproto.Bool
-	1,  // 36: session.RPCSession.session_sendHttpDataToSession:output_type -> session.HttpSessionData
-	19, // 37: session.RPCSession.session_closeSession:output_type -> mtproto.Bool
-	19, // 38: session.RPCSession.session_pushUpdatesData:output_type -> mtproto.Bool
-	19, // 39: session.RPCSession.session_pushSessionUpdatesData:output_type -> mtproto.Bool
-	19, // 40: session.RPCSession.session_pushRpcResultData:output_type -> mtproto.Bool
-	32, // [32:41] is the sub-list for method output_type
-	23, // [23:32] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	0,  // 2: session.SessionAuthKeyInfo.constructor:type_name -> session.TLConstructor
+	21, // 3: session.SessionAuthKeyInfo.auth_key:type_name -> mtproto.AuthKeyInfo
+	3,  // 4: session.TL_sessionAuthKeyInfo.data2:type_name -> session.SessionAuthKeyInfo
+	0,  // 5: session.SessionClientData.constructor:type_name -> session.TLConstructor
+	5,  // 6: session.TL_sessionClientData.data2:type_name -> session.SessionClientData
+	0,  // 7: session.SessionClientEvent.constructor:type_name -> session.TLConstructor
+	7,  // 8: session.TL_sessionClientEvent.data2:type_name -> session.SessionClientEvent
+	0,  // 9: session.TL_session_queryAuthKey.constructor:type_name -> session.TLConstructor
+	0,  // 10: session.TL_session_setAuthKey.constructor:type_name -> session.TLConstructor
+	21, // 11: session.TL_session_setAuthKey.auth_key:type_name -> mtproto.AuthKeyInfo
+	22, // 12: session.TL_session_setAuthKey.future_salt:type_name -> mtproto.FutureSalt
+	0,  // 13: session.TL_session_createSession.constructor:type_name -> session.TLConstructor
+	7,  // 14: session.TL_session_createSession.client:type_name -> session.SessionClientEvent
+	0,  // 15: session.TL_session_sendDataToSession.constructor:type_name -> session.TLConstructor
+	5,  // 16: session.TL_session_sendDataToSession.data:type_name -> session.SessionClientData
+	0,  // 17: session.TL_session_sendHttpDataToSession.constructor:type_name -> session.TLConstructor
+	5,  // 18: session.TL_session_sendHttpDataToSession.client:type_name -> session.SessionClientData
+	0,  // 19: session.TL_session_closeSession.constructor:type_name -> session.TLConstructor
+	7,  // 20: session.TL_session_closeSession.client:type_name -> session.SessionClientEvent
+	0,  // 21: session.TL_session_pushUpdatesData.constructor:type_name -> session.TLConstructor
+	23, // 22: session.TL_session_pushUpdatesData.updates:type_name -> mtproto.Updates
+	0,  // 23: session.TL_session_pushSessionUpdatesData.constructor:type_name -> session.TLConstructor
+	23, // 24: session.TL_session_pushSessionUpdatesData.updates:type_name -> mtproto.Updates
+	0,  // 25: session.TL_session_pushRpcResultData.constructor:type_name -> session.TLConstructor
+	0,  // 26: session.TL_session_invalidateAuthKey.constructor:type_name -> session.TLConstructor
+	0,  // 27: session.TL_session_bindTempAuthKey.constructor:type_name -> session.TLConstructor
+	0,  // 28: session.TL_session_queryAuthKeyInfo.constructor:type_name -> session.TLConstructor
+	9,  // 29: session.RPCSession.session_queryAuthKey:input_type -> session.TL_session_queryAuthKey
+	10, // 30: session.RPCSession.session_setAuthKey:input_type -> session.TL_session_setAuthKey
+	11, // 31: session.RPCSession.session_createSession:input_type -> session.TL_session_createSession
+	12, // 32: session.RPCSession.session_sendDataToSession:input_type -> session.TL_session_sendDataToSession
+	13, // 33: session.RPCSession.session_sendHttpDataToSession:input_type -> session.TL_session_sendHttpDataToSession
+	14, // 34: session.RPCSession.session_closeSession:input_type -> session.TL_session_closeSession
+	15, // 35: session.RPCSession.session_pushUpdatesData:input_type -> session.TL_session_pushUpdatesData
+	16, // 36: session.RPCSession.session_pushSessionUpdatesData:input_type -> session.TL_session_pushSessionUpdatesData
+	17, // 37: session.RPCSession.session_pushRpcResultData:input_type -> session.TL_session_pushRpcResultData
+	18, // 38: session.RPCSession.session_invalidateAuthKey:input_type -> session.TL_session_invalidateAuthKey
+	19, // 39: session.RPCSession.session_bindTempAuthKey:input_type -> session.TL_session_bindTempAuthKey
+	20, // 40: session.RPCSession.session_queryAuthKeyInfo:input_type -> session.TL_session_queryAuthKeyInfo
+	21, // 41: session.RPCSession.session_queryAuthKey:output_type -> mtproto.AuthKeyInfo
+	24, // 42: session.RPCSession.session_setAuthKey:output_type -> mtproto.Bool
+	24, // 43: session.RPCSession.session_createSession:output_type -> mtproto.Bool
+	24, // 44: session.RPCSession.session_sendDataToSession:output_type -> mtproto.Bool
+	1,  // 45: session.RPCSession.session_sendHttpDataToSession:output_type -> session.HttpSessionData
+	24, // 46: session.RPCSession.session_closeSession:output_type -> mtproto.Bool
+	24, // 47: session.RPCSession.session_pushUpdatesData:output_type -> mtproto.Bool
+	24, // 48: session.RPCSession.session_pushSessionUpdatesData:output_type -> mtproto.Bool
+	24, // 49: session.RPCSession.session_pushRpcResultData:output_type -> mtproto.Bool
+	24, // 50: session.RPCSession.session_invalidateAuthKey:output_type -> mtproto.Bool
+	24, // 51: session.RPCSession.session_bindTempAuthKey:output_type -> mtproto.Bool
+	3,  // 52: session.RPCSession.session_queryAuthKeyInfo:output_type -> session.SessionAuthKeyInfo
+	41, // [41:53] is the sub-list for method output_type
+	29, // [29:41] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_session_tl_proto_init() }
@@ -1520,7 +1934,7 @@ func file_session_tl_proto_init() {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_session_tl_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[0].Exporter = func(v any, i int) any {
 			switch v := v.(*HttpSessionData); i {
 			case 0:
 				return &v.state
@@ -1532,7 +1946,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*TLHttpSessionData); i {
 			case 0:
 				return &v.state
@@ -1544,7 +1958,31 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionAuthKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_tl_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSessionAuthKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_tl_proto_msgTypes[4].Exporter = func(v any, i int) any {
 			switch v := v.(*SessionClientData); i {
 			case 0:
 				return &v.state
@@ -1556,7 +1994,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[5].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionClientData); i {
 			case 0:
 				return &v.state
@@ -1568,7 +2006,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[6].Exporter = func(v any, i int) any {
 			switch v := v.(*SessionClientEvent); i {
 			case 0:
 				return &v.state
@@ -1580,7 +2018,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[7].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionClientEvent); i {
 			case 0:
 				return &v.state
@@ -1592,7 +2030,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionQueryAuthKey); i {
 			case 0:
 				return &v.state
@@ -1604,7 +2042,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[9].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionSetAuthKey); i {
 			case 0:
 				return &v.state
@@ -1616,7 +2054,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[10].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionCreateSession); i {
 			case 0:
 				return &v.state
@@ -1628,7 +2066,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[11].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionSendDataToSession); i {
 			case 0:
 				return &v.state
@@ -1640,7 +2078,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionSendHttpDataToSession); i {
 			case 0:
 				return &v.state
@@ -1652,7 +2090,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionCloseSession); i {
 			case 0:
 				return &v.state
@@ -1664,7 +2102,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionPushUpdatesData); i {
 			case 0:
 				return &v.state
@@ -1676,7 +2114,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionPushSessionUpdatesData); i {
 			case 0:
 				return &v.state
@@ -1688,7 +2126,7 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
-		file_session_tl_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_session_tl_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*TLSessionPushRpcResultData); i {
 			case 0:
 				return &v.state
@@ -1700,6 +2138,42 @@ func file_session_tl_proto_init() {
 				return nil
 			}
 		}
+		file_session_tl_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSessionInvalidateAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_tl_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSessionBindTempAuthKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_session_tl_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*TLSessionQueryAuthKeyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1707,7 +2181,7 @@ func file_session_tl_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_session_tl_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
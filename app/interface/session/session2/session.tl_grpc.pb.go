@@ -38,6 +38,9 @@ const (
 	RPCSession_SessionPushUpdatesData_FullMethodName        = "/session.RPCSession/session_pushUpdatesData"
 	RPCSession_SessionPushSessionUpdatesData_FullMethodName = "/session.RPCSession/session_pushSessionUpdatesData"
 	RPCSession_SessionPushRpcResultData_FullMethodName      = "/session.RPCSession/session_pushRpcResultData"
+	RPCSession_SessionInvalidateAuthKey_FullMethodName      = "/session.RPCSession/session_invalidateAuthKey"
+	RPCSession_SessionBindTempAuthKey_FullMethodName        = "/session.RPCSession/session_bindTempAuthKey"
+	RPCSession_SessionQueryAuthKeyInfo_FullMethodName       = "/session.RPCSession/session_queryAuthKeyInfo"
 )
 
 // RPCSessionClient is the client API for RPCSession service.
@@ -53,6 +56,9 @@ type RPCSessionClient interface {
 	SessionPushUpdatesData(ctx context.Context, in *TLSessionPushUpdatesData, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	SessionPushSessionUpdatesData(ctx context.Context, in *TLSessionPushSessionUpdatesData, opts ...grpc.CallOption) (*mtproto.Bool, error)
 	SessionPushRpcResultData(ctx context.Context, in *TLSessionPushRpcResultData, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	SessionInvalidateAuthKey(ctx context.Context, in *TLSessionInvalidateAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	SessionBindTempAuthKey(ctx context.Context, in *TLSessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error)
+	SessionQueryAuthKeyInfo(ctx context.Context, in *TLSessionQueryAuthKeyInfo, opts ...grpc.CallOption) (*SessionAuthKeyInfo, error)
 }
 
 type rPCSessionClient struct {
@@ -144,6 +150,33 @@ func (c *rPCSessionClient) SessionPushRpcResultData(ctx context.Context, in *TLS
 	return out, nil
 }
 
+func (c *rPCSessionClient) SessionInvalidateAuthKey(ctx context.Context, in *TLSessionInvalidateAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, RPCSession_SessionInvalidateAuthKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSessionClient) SessionBindTempAuthKey(ctx context.Context, in *TLSessionBindTempAuthKey, opts ...grpc.CallOption) (*mtproto.Bool, error) {
+	out := new(mtproto.Bool)
+	err := c.cc.Invoke(ctx, RPCSession_SessionBindTempAuthKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rPCSessionClient) SessionQueryAuthKeyInfo(ctx context.Context, in *TLSessionQueryAuthKeyInfo, opts ...grpc.CallOption) (*SessionAuthKeyInfo, error) {
+	out := new(SessionAuthKeyInfo)
+	err := c.cc.Invoke(ctx, RPCSession_SessionQueryAuthKeyInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RPCSessionServer is the server API for RPCSession service.
 // All implementations should embed UnimplementedRPCSessionServer
 // for forward compatibility
@@ -157,6 +190,9 @@ type RPCSessionServer interface {
 	SessionPushUpdatesData(context.Context, *TLSessionPushUpdatesData) (*mtproto.Bool, error)
 	SessionPushSessionUpdatesData(context.Context, *TLSessionPushSessionUpdatesData) (*mtproto.Bool, error)
 	SessionPushRpcResultData(context.Context, *TLSessionPushRpcResultData) (*mtproto.Bool, error)
+	SessionInvalidateAuthKey(context.Context, *TLSessionInvalidateAuthKey) (*mtproto.Bool, error)
+	SessionBindTempAuthKey(context.Context, *TLSessionBindTempAuthKey) (*mtproto.Bool, error)
+	SessionQueryAuthKeyInfo(context.Context, *TLSessionQueryAuthKeyInfo) (*SessionAuthKeyInfo, error)
 }
 
 // UnimplementedRPCSessionServer should be embedded to have forward compatible implementations.
@@ -190,6 +226,15 @@ func (UnimplementedRPCSessionServer) SessionPushSessionUpdatesData(context.Conte
 func (UnimplementedRPCSessionServer) SessionPushRpcResultData(context.Context, *TLSessionPushRpcResultData) (*mtproto.Bool, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SessionPushRpcResultData not implemented")
 }
+func (UnimplementedRPCSessionServer) SessionInvalidateAuthKey(context.Context, *TLSessionInvalidateAuthKey) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionInvalidateAuthKey not implemented")
+}
+func (UnimplementedRPCSessionServer) SessionBindTempAuthKey(context.Context, *TLSessionBindTempAuthKey) (*mtproto.Bool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionBindTempAuthKey not implemented")
+}
+func (UnimplementedRPCSessionServer) SessionQueryAuthKeyInfo(context.Context, *TLSessionQueryAuthKeyInfo) (*SessionAuthKeyInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionQueryAuthKeyInfo not implemented")
+}
 
 // UnsafeRPCSessionServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RPCSessionServer will
@@ -364,6 +409,60 @@ func _RPCSession_SessionPushRpcResultData_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RPCSession_SessionInvalidateAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSessionInvalidateAuthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSessionServer).SessionInvalidateAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCSession_SessionInvalidateAuthKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSessionServer).SessionInvalidateAuthKey(ctx, req.(*TLSessionInvalidateAuthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSession_SessionBindTempAuthKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSessionBindTempAuthKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSessionServer).SessionBindTempAuthKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCSession_SessionBindTempAuthKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSessionServer).SessionBindTempAuthKey(ctx, req.(*TLSessionBindTempAuthKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RPCSession_SessionQueryAuthKeyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TLSessionQueryAuthKeyInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RPCSessionServer).SessionQueryAuthKeyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RPCSession_SessionQueryAuthKeyInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RPCSessionServer).SessionQueryAuthKeyInfo(ctx, req.(*TLSessionQueryAuthKeyInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RPCSession_ServiceDesc is the grpc.ServiceDesc for RPCSession service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -407,6 +506,18 @@ var RPCSession_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "session_pushRpcResultData",
 			Handler:    _RPCSession_SessionPushRpcResultData_Handler,
 		},
+		{
+			MethodName: "session_invalidateAuthKey",
+			Handler:    _RPCSession_SessionInvalidateAuthKey_Handler,
+		},
+		{
+			MethodName: "session_bindTempAuthKey",
+			Handler:    _RPCSession_SessionBindTempAuthKey_Handler,
+		},
+		{
+			MethodName: "session_queryAuthKeyInfo",
+			Handler:    _RPCSession_SessionQueryAuthKeyInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "session.tl.proto",
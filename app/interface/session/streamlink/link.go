@@ -0,0 +1,433 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package streamlink carries batched session traffic over one long-lived bidirectional
+// stream per gnetway <-> session node pair.
+//
+// Every side numbers its frames and keeps them until the peer acks them, at most
+// Window frames are in flight. A frame is acked once the handler of the peer ran its
+// items. A Link outlives its transport: after a reconnect the unacked frames are sent
+// again, the epoch lets the peer tell a restart from a resend.
+package streamlink
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The errors of Push are codes.Unavailable, like a unary call to a peer that is gone.
+var (
+	ErrLinkClosed = status.Error(codes.Unavailable, "streamlink: link closed")
+	ErrLinkDown   = status.Error(codes.Unavailable, "streamlink: no stream to the peer")
+	ErrLinkBusy   = status.Error(codes.Unavailable, "streamlink: queue full")
+	ErrNotAcked   = status.Error(codes.Unavailable, "streamlink: not acked in time")
+)
+
+// Config of a Link, the zero value of a field means its default.
+type Config struct {
+	Window        int           `json:",default=64"`
+	MaxBatch      int           `json:",default=256"`
+	QueueSize     int           `json:",default=8192"`
+	FlushInterval time.Duration `json:",default=2ms"`
+	// PushTimeout bounds a Push whose context has no deadline
+	PushTimeout time.Duration `json:",default=5s"`
+}
+
+func (c Config) withDefaults() Config {
+	if c.Window <= 0 {
+		c.Window = 64
+	}
+	if c.MaxBatch <= 0 {
+		c.MaxBatch = 256
+	}
+	if c.QueueSize <= 0 {
+		c.QueueSize = 8192
+	}
+	if c.FlushInterval <= 0 {
+		c.FlushInterval = 2 * time.Millisecond
+	}
+	if c.PushTimeout <= 0 {
+		c.PushTimeout = 5 * time.Second
+	}
+	return c
+}
+
+// Transport is the grpc stream of either side.
+type Transport interface {
+	Send(*session.SessionStreamFrame) error
+	Recv() (*session.SessionStreamFrame, error)
+}
+
+// Handler is called in order for every item the peer sent, off the goroutine reading
+// the stream, so it may Push to the same link and wait for the ack.
+type Handler func(item *session.SessionStreamItem)
+
+// pushed is an item waiting in the queue or in an unacked frame.
+type pushed struct {
+	item *session.SessionStreamItem
+	done chan error
+}
+
+// outFrame is a frame sent and not acked yet.
+type outFrame struct {
+	frame  *session.SessionStreamFrame
+	pushed []*pushed
+}
+
+// inFrame is a frame of the peer waiting for the handler.
+type inFrame struct {
+	t     Transport
+	epoch int64
+	frame *session.SessionStreamFrame
+}
+
+type Link struct {
+	c        Config
+	serverId string
+	epoch    int64
+	handler  Handler
+	queue    chan *pushed
+	received chan inFrame
+	closed   chan struct{}
+	once     sync.Once
+
+	sendMu sync.Mutex // grpc streams don't allow concurrent Send
+
+	mu        sync.Mutex
+	cond      *sync.Cond
+	transport Transport
+	seq       int64
+	inflight  []*outFrame
+	peerWin   int
+	peerEpoch int64
+	// recvSeq is the newest frame of the peer read, ackSeq the newest one handled
+	recvSeq int64
+	ackSeq  int64
+}
+
+// New creates a link, serverId is sent to the peer by the gnetway side.
+func New(c Config, serverId string, handler Handler) *Link {
+	l := &Link{
+		c:        c.withDefaults(),
+		serverId: serverId,
+		epoch:    time.Now().UnixNano() ^ rand.Int63(),
+		handler:  handler,
+		closed:   make(chan struct{}),
+	}
+	l.cond = sync.NewCond(&l.mu)
+	l.queue = make(chan *pushed, l.c.QueueSize)
+	// the peer has at most Window frames in flight
+	l.received = make(chan inFrame, l.c.Window)
+	l.peerWin = l.c.Window
+
+	go l.sendLoop()
+	go l.handleLoop()
+
+	return l
+}
+
+// Push sends item and waits until the peer handled it, up to the deadline of ctx or
+// PushTimeout if ctx has none. It fails fast if the link has no stream or its queue is
+// full, the caller may then try another peer.
+func (l *Link) Push(ctx context.Context, item *session.SessionStreamItem) error {
+	if !l.Connected() {
+		select {
+		case <-l.closed:
+			return ErrLinkClosed
+		default:
+			return ErrLinkDown
+		}
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.c.PushTimeout)
+		defer cancel()
+	}
+
+	p := &pushed{
+		item: item,
+		done: make(chan error, 1),
+	}
+	select {
+	case l.queue <- p:
+	case <-l.closed:
+		return ErrLinkClosed
+	default:
+		return ErrLinkBusy
+	}
+
+	select {
+	case err := <-p.done:
+		return err
+	case <-l.closed:
+		return ErrLinkClosed
+	case <-ctx.Done():
+		// it stays queued, the peer may still get it
+		return ErrNotAcked
+	}
+}
+
+// Connected reports whether the link has a transport right now.
+func (l *Link) Connected() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.transport != nil
+}
+
+// Done is closed once the link is closed.
+func (l *Link) Done() <-chan struct{} {
+	return l.closed
+}
+
+func (l *Link) Close() {
+	l.once.Do(func() {
+		close(l.closed)
+
+		l.mu.Lock()
+		l.transport = nil
+		l.cond.Broadcast()
+		l.mu.Unlock()
+	})
+}
+
+// Serve attaches t, resends the unacked frames and reads from t until it fails.
+// first is a frame the caller already read from t, e.g. to find the link of a gnetway.
+func (l *Link) Serve(t Transport, first *session.SessionStreamFrame) error {
+	// no new frames until the unacked ones went out again, the peer drops
+	// everything older than the newest frame it has seen
+	l.mu.Lock()
+	l.transport = nil
+	inflight := append([]*outFrame(nil), l.inflight...)
+	l.mu.Unlock()
+
+	defer l.detach(t)
+
+	// hello, tells the peer who we are and what we have got so far
+	if err := l.send(t, l.controlFrame()); err != nil {
+		return err
+	}
+	for _, out := range inflight {
+		if err := l.send(t, out.frame); err != nil {
+			return err
+		}
+	}
+
+	l.mu.Lock()
+	l.transport = t
+	l.cond.Broadcast()
+	l.mu.Unlock()
+
+	if first != nil {
+		l.onFrame(t, first)
+	}
+
+	for {
+		frame, err := t.Recv()
+		if err != nil {
+			return err
+		}
+		l.onFrame(t, frame)
+	}
+}
+
+func (l *Link) detach(t Transport) {
+	l.mu.Lock()
+	if l.transport == t {
+		l.transport = nil
+	}
+	l.mu.Unlock()
+}
+
+func (l *Link) controlFrame() *session.SessionStreamFrame {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return &session.SessionStreamFrame{
+		Epoch:    l.epoch,
+		Ack:      l.ackSeq,
+		Window:   int32(l.c.Window),
+		ServerId: l.serverId,
+	}
+}
+
+func (l *Link) send(t Transport, frame *session.SessionStreamFrame) error {
+	l.sendMu.Lock()
+	defer l.sendMu.Unlock()
+
+	return t.Send(frame)
+}
+
+func (l *Link) onFrame(t Transport, frame *session.SessionStreamFrame) {
+	var acked []*outFrame
+
+	l.mu.Lock()
+	if frame.Epoch != l.peerEpoch {
+		// the peer restarted, it never saw our frames nor we its
+		l.peerEpoch = frame.Epoch
+		l.recvSeq = 0
+		l.ackSeq = 0
+	}
+	if frame.Window > 0 {
+		l.peerWin = int(frame.Window)
+	}
+	n := 0
+	for n < len(l.inflight) && l.inflight[n].frame.Seq <= frame.Ack {
+		n++
+	}
+	if n > 0 {
+		acked = append(acked, l.inflight[:n]...)
+		l.inflight = append(l.inflight[:0], l.inflight[n:]...)
+	}
+	l.cond.Broadcast()
+
+	fresh := frame.Seq > l.recvSeq
+	if fresh {
+		l.recvSeq = frame.Seq
+	}
+	epoch := l.peerEpoch
+	l.mu.Unlock()
+
+	for _, out := range acked {
+		for _, p := range out.pushed {
+			p.done <- nil
+		}
+	}
+
+	if frame.Seq == 0 || !fresh {
+		return
+	}
+
+	select {
+	case l.received <- inFrame{t: t, epoch: epoch, frame: frame}:
+	case <-l.closed:
+	}
+}
+
+// handleLoop runs the handler on the frames of the peer and acks them.
+func (l *Link) handleLoop() {
+	for {
+		var in inFrame
+		select {
+		case in = <-l.received:
+		case <-l.closed:
+			return
+		}
+
+		for _, item := range in.frame.Items {
+			l.handler(item)
+		}
+
+		l.mu.Lock()
+		if in.epoch == l.peerEpoch && in.frame.Seq > l.ackSeq {
+			l.ackSeq = in.frame.Seq
+		}
+		l.mu.Unlock()
+
+		// ack right away, our own frames may be stuck behind the window of the peer
+		if err := l.send(in.t, l.controlFrame()); err != nil {
+			logx.Errorf("streamlink(%s) - send ack error: %v", l.serverId, err)
+		}
+	}
+}
+
+func (l *Link) sendLoop() {
+	var (
+		batch = make([]*pushed, 0, l.c.MaxBatch)
+		timer = time.NewTimer(l.c.FlushInterval)
+	)
+	defer timer.Stop()
+
+	for {
+		select {
+		case item := <-l.queue:
+			batch = append(batch, item)
+		case <-l.closed:
+			return
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(l.c.FlushInterval)
+
+	Collect:
+		for len(batch) < l.c.MaxBatch {
+			select {
+			case item := <-l.queue:
+				batch = append(batch, item)
+			case <-timer.C:
+				break Collect
+			case <-l.closed:
+				return
+			}
+		}
+
+		l.flush(batch)
+		batch = make([]*pushed, 0, l.c.MaxBatch)
+	}
+}
+
+// flush waits for a transport and a free slot in the window, then sends batch.
+func (l *Link) flush(batch []*pushed) {
+	l.mu.Lock()
+	for l.transport == nil || len(l.inflight) >= l.peerWin {
+		select {
+		case <-l.closed:
+			l.mu.Unlock()
+			return
+		default:
+		}
+		l.cond.Wait()
+	}
+
+	items := make([]*session.SessionStreamItem, 0, len(batch))
+	for _, p := range batch {
+		items = append(items, p.item)
+	}
+
+	l.seq++
+	frame := &session.SessionStreamFrame{
+		Epoch:  l.epoch,
+		Seq:    l.seq,
+		Ack:    l.ackSeq,
+		Window: int32(l.c.Window),
+		Items:  items,
+	}
+	l.inflight = append(l.inflight, &outFrame{frame: frame, pushed: batch})
+	t := l.transport
+	l.mu.Unlock()
+
+	if err := l.send(t, frame); err != nil {
+		// kept in inflight, Serve sends it again on the next transport
+		logx.Errorf("streamlink(%s) - send frame(%d) error: %v", l.serverId, frame.Seq, err)
+		l.detach(t)
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package streamlink
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/teamgram/teamgram-server/v2/app/interface/session/session2"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pipeEnd is one end of an in-memory stream.
+type pipeEnd struct {
+	recv <-chan *session.SessionStreamFrame
+	send chan<- *session.SessionStreamFrame
+	done chan struct{}
+	once *sync.Once
+}
+
+func newPipe() (*pipeEnd, *pipeEnd) {
+	var (
+		c1   = make(chan *session.SessionStreamFrame, 64)
+		c2   = make(chan *session.SessionStreamFrame, 64)
+		done = make(chan struct{})
+		once = new(sync.Once)
+	)
+
+	return &pipeEnd{recv: c1, send: c2, done: done, once: once},
+		&pipeEnd{recv: c2, send: c1, done: done, once: once}
+}
+
+func (p *pipeEnd) Send(frame *session.SessionStreamFrame) error {
+	select {
+	case p.send <- frame:
+		return nil
+	case <-p.done:
+		return io.EOF
+	}
+}
+
+func (p *pipeEnd) Recv() (*session.SessionStreamFrame, error) {
+	select {
+	case frame := <-p.recv:
+		return frame, nil
+	case <-p.done:
+		return nil, io.EOF
+	}
+}
+
+func (p *pipeEnd) Close() {
+	p.once.Do(func() { close(p.done) })
+}
+
+// next is the next frame sent to p, nil if there is none for a while.
+func (p *pipeEnd) next(wait time.Duration) *session.SessionStreamFrame {
+	select {
+	case frame := <-p.recv:
+		return frame
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); {
+		if cond() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timeout waiting for %s", what)
+}
+
+func item(authKeyId int64) *session.SessionStreamItem {
+	return &session.SessionStreamItem{
+		Item: &session.SessionStreamItem_GatewayData{
+			GatewayData: &session.SessionStreamGatewayData{AuthKeyId: authKeyId},
+		},
+	}
+}
+
+func itemId(item *session.SessionStreamItem) int64 {
+	return item.GetGatewayData().GetAuthKeyId()
+}
+
+// recorder is a Handler keeping the items it got.
+type recorder struct {
+	mu  sync.Mutex
+	ids []int64
+}
+
+func (r *recorder) handle(item *session.SessionStreamItem) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.ids = append(r.ids, itemId(item))
+}
+
+func (r *recorder) got() []int64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]int64(nil), r.ids...)
+}
+
+func equalIds(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func noop(*session.SessionStreamItem) {}
+
+func TestLinkPushDelivered(t *testing.T) {
+	var (
+		r    recorder
+		cfg  = Config{FlushInterval: time.Millisecond}
+		a    = New(cfg, "gateway", noop)
+		b    = New(cfg, "", r.handle)
+		p, q = newPipe()
+	)
+	defer a.Close()
+	defer b.Close()
+
+	go a.Serve(p, nil)
+	go b.Serve(q, nil)
+	waitFor(t, "connected", func() bool { return a.Connected() && b.Connected() })
+
+	for i := int64(1); i <= 3; i++ {
+		if err := a.Push(context.Background(), item(i)); err != nil {
+			t.Fatalf("Push(%d) error: %v", i, err)
+		}
+		// Push returns once the peer handled the item
+		if got := r.got(); len(got) != int(i) || got[i-1] != i {
+			t.Fatalf("after Push(%d) the peer got %v", i, got)
+		}
+	}
+
+	a.mu.Lock()
+	seq, inflight := a.seq, len(a.inflight)
+	a.mu.Unlock()
+	if seq != 3 || inflight != 0 {
+		t.Fatalf("seq = %d, inflight = %d, want 3, 0", seq, inflight)
+	}
+}
+
+func TestLinkWindow(t *testing.T) {
+	var (
+		l    = New(Config{Window: 4, MaxBatch: 1, FlushInterval: time.Millisecond}, "gateway", noop)
+		p, q = newPipe()
+	)
+	defer l.Close()
+
+	go l.Serve(p, nil)
+	if hello := q.next(time.Second); hello == nil || hello.Seq != 0 || hello.ServerId != "gateway" {
+		t.Fatalf("hello = %v", hello)
+	}
+
+	// the peer grants one frame
+	q.Send(&session.SessionStreamFrame{Epoch: 7, Window: 1})
+	waitFor(t, "window", func() bool {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return l.peerWin == 1
+	})
+
+	errc := make(chan error, 2)
+	for i := int64(1); i <= 2; i++ {
+		i := i
+		go func() { errc <- l.Push(context.Background(), item(i)) }()
+	}
+
+	f1 := q.next(time.Second)
+	if f1 == nil || f1.Seq != 1 || len(f1.Items) != 1 {
+		t.Fatalf("first frame = %v", f1)
+	}
+	if f := q.next(50 * time.Millisecond); f != nil {
+		t.Fatalf("frame %d sent past the window", f.Seq)
+	}
+
+	q.Send(&session.SessionStreamFrame{Epoch: 7, Ack: 1})
+	if err := <-errc; err != nil {
+		t.Fatalf("Push error: %v", err)
+	}
+
+	f2 := q.next(time.Second)
+	if f2 == nil || f2.Seq != 2 {
+		t.Fatalf("second frame = %v", f2)
+	}
+	if itemId(f1.Items[0]) == itemId(f2.Items[0]) {
+		t.Fatalf("the same item was sent twice")
+	}
+
+	q.Send(&session.SessionStreamFrame{Epoch: 7, Ack: 2})
+	if err := <-errc; err != nil {
+		t.Fatalf("Push error: %v", err)
+	}
+}
+
+func TestLinkResendOnReconnect(t *testing.T) {
+	var (
+		r   recorder
+		cfg = Config{FlushInterval: time.Millisecond}
+		a   = New(cfg, "gateway", noop)
+		b   = New(cfg, "", r.handle)
+	)
+	defer a.Close()
+	defer b.Close()
+
+	// the first stream loses the frame
+	p1, _ := newPipe()
+	go a.Serve(p1, nil)
+	waitFor(t, "connected", a.Connected)
+
+	errc := make(chan error, 1)
+	go func() { errc <- a.Push(context.Background(), item(1)) }()
+	waitFor(t, "frame in flight", func() bool {
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		return len(a.inflight) == 1
+	})
+	p1.Close()
+	waitFor(t, "disconnected", func() bool { return !a.Connected() })
+
+	p2, q2 := newPipe()
+	go a.Serve(p2, nil)
+	go b.Serve(q2, nil)
+
+	if err := <-errc; err != nil {
+		t.Fatalf("Push error: %v", err)
+	}
+	if err := a.Push(context.Background(), item(2)); err != nil {
+		t.Fatalf("Push error: %v", err)
+	}
+	if got := r.got(); !equalIds(got, []int64{1, 2}) {
+		t.Fatalf("the peer got %v, want [1 2]", got)
+	}
+}
+
+func TestLinkSeqAndEpoch(t *testing.T) {
+	var (
+		r    recorder
+		l    = New(Config{}, "", r.handle)
+		p, q = newPipe()
+	)
+	defer l.Close()
+
+	go l.Serve(p, nil)
+	q.next(time.Second) // hello
+
+	cases := []struct {
+		name    string
+		frame   *session.SessionStreamFrame
+		want    []int64
+		wantAck int64 // -1 for no ack
+	}{
+		{"first frame", &session.SessionStreamFrame{Epoch: 1, Seq: 1, Items: []*session.SessionStreamItem{item(1)}}, []int64{1}, 1},
+		{"resent frame", &session.SessionStreamFrame{Epoch: 1, Seq: 1, Items: []*session.SessionStreamItem{item(1)}}, []int64{1}, -1},
+		{"next frame", &session.SessionStreamFrame{Epoch: 1, Seq: 2, Items: []*session.SessionStreamItem{item(2), item(3)}}, []int64{1, 2, 3}, 2},
+		{"control frame", &session.SessionStreamFrame{Epoch: 1, Ack: 0}, []int64{1, 2, 3}, -1},
+		{"peer restarted", &session.SessionStreamFrame{Epoch: 2, Seq: 1, Items: []*session.SessionStreamItem{item(4)}}, []int64{1, 2, 3, 4}, 1},
+	}
+
+	for _, tc := range cases {
+		q.Send(tc.frame)
+
+		ack := q.next(100 * time.Millisecond)
+		switch {
+		case tc.wantAck < 0 && ack != nil:
+			t.Fatalf("%s: unexpected ack %d", tc.name, ack.Ack)
+		case tc.wantAck >= 0 && (ack == nil || ack.Ack != tc.wantAck):
+			t.Fatalf("%s: ack = %v, want %d", tc.name, ack, tc.wantAck)
+		}
+		if got := r.got(); !equalIds(got, tc.want) {
+			t.Fatalf("%s: handled %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestLinkPushErrors(t *testing.T) {
+	// not connected
+	l := New(Config{}, "", noop)
+	if err := l.Push(context.Background(), item(1)); err != ErrLinkDown {
+		t.Fatalf("Push() = %v, want ErrLinkDown", err)
+	}
+	l.Close()
+	if err := l.Push(context.Background(), item(1)); err != ErrLinkClosed {
+		t.Fatalf("Push() = %v, want ErrLinkClosed", err)
+	}
+
+	// connected, the peer never acks
+	l = New(Config{PushTimeout: 20 * time.Millisecond, FlushInterval: time.Millisecond}, "", noop)
+	defer l.Close()
+	p, _ := newPipe()
+	go l.Serve(p, nil)
+	waitFor(t, "connected", l.Connected)
+
+	if err := l.Push(context.Background(), item(1)); err != ErrNotAcked {
+		t.Fatalf("Push() without deadline = %v, want ErrNotAcked", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := l.Push(ctx, item(2)); err != ErrNotAcked {
+		t.Fatalf("Push() with deadline = %v, want ErrNotAcked", err)
+	}
+
+	for _, err := range []error{ErrLinkDown, ErrLinkClosed, ErrLinkBusy, ErrNotAcked} {
+		if status.Code(err) != codes.Unavailable {
+			t.Errorf("%v isn't codes.Unavailable", err)
+		}
+	}
+}
+
+func TestLinkQueueFull(t *testing.T) {
+	l := New(Config{Window: 1, MaxBatch: 1, QueueSize: 1, PushTimeout: time.Second, FlushInterval: time.Millisecond}, "", noop)
+	defer l.Close()
+
+	p, _ := newPipe()
+	go l.Serve(p, nil)
+	waitFor(t, "connected", l.Connected)
+
+	// one frame in flight, one batch waiting for the window, one item queued
+	go l.Push(context.Background(), item(1))
+	waitFor(t, "frame in flight", func() bool {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return len(l.inflight) == 1
+	})
+	go l.Push(context.Background(), item(2))
+	waitFor(t, "batch taken", func() bool { return len(l.queue) == 0 })
+	time.Sleep(20 * time.Millisecond)
+	go l.Push(context.Background(), item(3))
+	waitFor(t, "item queued", func() bool { return len(l.queue) == 1 })
+
+	if err := l.Push(context.Background(), item(4)); err != ErrLinkBusy {
+		t.Fatalf("Push() = %v, want ErrLinkBusy", err)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package conf2 loads a config like go-zero's conf, but strictly: a key the config doesn't
+// have is an error instead of being dropped, and a config implementing Validator is
+// validated once loaded.
+package conf2
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"gopkg.in/yaml.v3"
+)
+
+// Validator is a config that can check itself after it was loaded.
+type Validator interface {
+	Validate() error
+}
+
+// Load loads file into v, .json, .yaml and .yml are acceptable.
+func Load(file string, v any) error {
+	if err := CheckUnknownKeys(file, v); err != nil {
+		return err
+	}
+	if err := conf.Load(file, v); err != nil {
+		return err
+	}
+
+	if validator, ok := v.(Validator); ok {
+		return validator.Validate()
+	}
+
+	return nil
+}
+
+// MustLoad loads file into v, exits on error.
+func MustLoad(file string, v any) {
+	if err := Load(file, v); err != nil {
+		log.Fatalf("error: config file %s, %s", file, err.Error())
+	}
+}
+
+// CheckUnknownKeys returns an error naming every key of file that v has no field for,
+// keys match case-insensitively like go-zero does.
+func CheckUnknownKeys(file string, v any) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var m map[string]any
+	switch strings.ToLower(path.Ext(file)) {
+	case ".json":
+		err = json.Unmarshal(content, &m)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &m)
+	default:
+		return fmt.Errorf("unrecognized file type: %s", file)
+	}
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	checkKeys(m, reflect.TypeOf(v), "", &unknown)
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown keys: %s", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+func checkKeys(v any, t reflect.Type, prefix string, unknown *[]string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := v.(map[string]any)
+		if !ok {
+			return
+		}
+
+		fields := make(map[string]reflect.Type)
+		collectFields(t, fields)
+		for key, val := range m {
+			ft, ok := fields[strings.ToLower(key)]
+			if !ok {
+				*unknown = append(*unknown, prefix+key)
+				continue
+			}
+			checkKeys(val, ft, prefix+key+".", unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		if items, ok := v.([]any); ok {
+			for i, item := range items {
+				checkKeys(item, t.Elem(), fmt.Sprintf("%s[%d].", strings.TrimSuffix(prefix, "."), i), unknown)
+			}
+		}
+	case reflect.Map:
+		if m, ok := v.(map[string]any); ok {
+			for key, val := range m {
+				checkKeys(val, t.Elem(), prefix+key+".", unknown)
+			}
+		}
+	}
+}
+
+// collectFields maps the lower case key of every field of t to its type, the fields of
+// an embedded struct without a key of its own belong to t.
+func collectFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			for ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				collectFields(ft, fields)
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		fields[strings.ToLower(name)] = field.Type
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package conf2
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type BaseConf struct {
+	Name string
+	Mode string `json:",optional"`
+}
+
+type testListener struct {
+	Addr string
+	Tls  bool `json:",optional"`
+}
+
+type testConfig struct {
+	BaseConf
+	Log struct {
+		Level string
+		Path  string `json:"path,optional"`
+	}
+	Listeners []testListener
+	Peers     map[string]testListener `json:",optional"`
+	Limits    *struct{ Max int }      `json:",optional"`
+	Ignored   string                  `json:"-"`
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return file
+}
+
+func TestCheckUnknownKeys(t *testing.T) {
+	cases := []struct {
+		name    string
+		file    string
+		content string
+		wantErr string
+		prefix  bool
+	}{
+		{
+			name: "valid",
+			file: "ok.yaml",
+			content: `
+Name: session
+Mode: dev
+Log:
+  Level: info
+  path: /tmp
+Listeners:
+  - Addr: 0.0.0.0:443
+    Tls: true
+Peers:
+  a:
+    Addr: 10.0.0.1:20450
+Limits:
+  Max: 10
+`,
+		},
+		{
+			name:    "keys match case-insensitively",
+			file:    "case.yaml",
+			content: "name: session\nlog:\n  LEVEL: info\n",
+		},
+		{
+			name:    "unknown key",
+			file:    "top.yaml",
+			content: "Name: session\nLsitenOn: 0.0.0.0:443\n",
+			wantErr: "unknown keys: LsitenOn",
+		},
+		{
+			name:    "unknown nested keys, sorted",
+			file:    "nested.yaml",
+			content: "Log:\n  Level: info\n  Lvl: debug\nLimits:\n  Min: 1\n",
+			wantErr: "unknown keys: Limits.Min, Log.Lvl",
+		},
+		{
+			name:    "unknown key in a list item",
+			file:    "list.yaml",
+			content: "Listeners:\n  - Addr: a\n  - Addr: b\n    Port: 1\n",
+			wantErr: "unknown keys: Listeners[1].Port",
+		},
+		{
+			name:    "unknown key in a map value",
+			file:    "map.yaml",
+			content: "Peers:\n  a:\n    Adr: x\n",
+			wantErr: "unknown keys: Peers.a.Adr",
+		},
+		{
+			name:    "a field left out with -",
+			file:    "ignored.yaml",
+			content: "Ignored: x\n",
+			wantErr: "unknown keys: Ignored",
+		},
+		{
+			name:    "json",
+			file:    "config.json",
+			content: `{"Name": "session", "Log": {"Level": "info", "Colour": true}}`,
+			wantErr: "unknown keys: Log.Colour",
+		},
+		{
+			name:    "unrecognized file type",
+			file:    "config.toml",
+			content: "Name = 'session'\n",
+			wantErr: "unrecognized file type: ",
+			prefix:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			file := writeConfig(t, tc.file, tc.content)
+
+			err := CheckUnknownKeys(file, &testConfig{})
+			switch {
+			case tc.wantErr == "":
+				if err != nil {
+					t.Fatalf("CheckUnknownKeys() error: %v", err)
+				}
+			case err == nil:
+				t.Fatalf("CheckUnknownKeys() = nil, want %q", tc.wantErr)
+			case tc.prefix && !strings.HasPrefix(err.Error(), tc.wantErr),
+				!tc.prefix && err.Error() != tc.wantErr:
+				t.Fatalf("CheckUnknownKeys() = %q, want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestListenConflict(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"127.0.0.1:20110", "127.0.0.1:20110", true},
+		{"0.0.0.0:20110", ":20110", true},
+		{"0.0.0.0:20110", "127.0.0.1:20110", true},
+		{"[::]:20110", "10.0.0.1:20110", true},
+		{":20110", "[::1]:20110", true},
+		{"[::1]:20110", "[0:0:0:0:0:0:0:1]:20110", true},
+		{"localhost:20110", "LOCALHOST:20110", true},
+		{"127.0.0.1:20110", "127.0.0.1:020110", true},
+		{"0.0.0.0:20110", "0.0.0.0:20111", false},
+		{"127.0.0.1:20110", "127.0.0.2:20110", false},
+		{"127.0.0.1:20110", "[::1]:20110", false},
+		{"20110", ":20110", false},
+	}
+
+	for _, tc := range cases {
+		if got := ListenConflict(tc.a, tc.b); got != tc.want {
+			t.Errorf("ListenConflict(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+		if got := ListenConflict(tc.b, tc.a); got != tc.want {
+			t.Errorf("ListenConflict(%q, %q) = %v, want %v", tc.b, tc.a, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+package conf2
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ListenConflict reports whether listening on a and on b takes the same port twice: the
+// ports are the same and so are the hosts, or one of them is a wildcard, an empty host,
+// 0.0.0.0 or ::. An address that doesn't parse conflicts with nothing.
+func ListenConflict(a, b string) bool {
+	hostA, portA, err := net.SplitHostPort(a)
+	if err != nil {
+		return false
+	}
+	hostB, portB, err := net.SplitHostPort(b)
+	if err != nil {
+		return false
+	}
+
+	if !samePort(portA, portB) {
+		return false
+	}
+	if isWildcardHost(hostA) || isWildcardHost(hostB) {
+		return true
+	}
+	if ipA, ipB := net.ParseIP(hostA), net.ParseIP(hostB); ipA != nil && ipB != nil {
+		return ipA.Equal(ipB)
+	}
+
+	return strings.EqualFold(hostA, hostB)
+}
+
+func samePort(a, b string) bool {
+	portA, errA := strconv.Atoi(a)
+	portB, errB := strconv.Atoi(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return portA == portB
+}
+
+func isWildcardHost(host string) bool {
+	if host == "" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsUnspecified()
+}
@@ -0,0 +1,102 @@
+// Copyright 2024 Teamgram Authors
+//  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Author: teamgramio (teamgram.io@gmail.com)
+//
+
+// Package dcoption describes the gnetway listeners as the clients see them, the dc_options
+// of help.getConfig. The gnetway listens on them, the session advertises them.
+package dcoption
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/teamgram/proto/mtproto"
+)
+
+// Options is what a listener advertises, IPs are the public addresses the clients reach it
+// by, the host of every address when empty.
+type Options struct {
+	DcId      int      `json:",default=1"`
+	IPs       []string `json:",optional"`
+	MediaOnly bool     `json:",optional"`
+	TcpoOnly  bool     `json:",optional"`
+	Static    bool     `json:",optional"`
+}
+
+// Listener is a gnetway listener, the gnetway config calls it GnetwayServer.
+type Listener struct {
+	Proto     string `json:",default=tcp,options=tcp|websocket|http"`
+	Addresses []string
+	Options
+}
+
+// DcOptions returns a dc_option for every public ip and port of l, the clients only
+// connect by tcp to a dc_option, the other listeners have none.
+func (l Listener) DcOptions() ([]*mtproto.DcOption, error) {
+	if l.Proto != "" && l.Proto != "tcp" {
+		return nil, nil
+	}
+
+	var options []*mtproto.DcOption
+	for _, address := range l.Addresses {
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil || p <= 0 || p > 65535 {
+			return nil, fmt.Errorf("%s: invalid port", address)
+		}
+
+		ips := l.IPs
+		if len(ips) == 0 {
+			ips = []string{host}
+		}
+		for _, v := range ips {
+			ip := net.ParseIP(v)
+			if ip == nil || ip.IsUnspecified() {
+				return nil, fmt.Errorf("%s: %q is no address a client can reach, set IPs", address, v)
+			}
+			options = append(options, mtproto.MakeTLDcOption(&mtproto.DcOption{
+				Ipv6:      ip.To4() == nil,
+				MediaOnly: l.MediaOnly,
+				TcpoOnly:  l.TcpoOnly,
+				Static:    l.Static,
+				Id:        int32(l.DcId),
+				IpAddress: ip.String(),
+				Port:      int32(p),
+			}).To_DcOption())
+		}
+	}
+
+	return options, nil
+}
+
+// DcOptions returns the dc_options of all the listeners.
+func DcOptions(listeners []Listener) ([]*mtproto.DcOption, error) {
+	var options []*mtproto.DcOption
+	for i, l := range listeners {
+		o, err := l.DcOptions()
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %v", i, err)
+		}
+		options = append(options, o...)
+	}
+
+	return options, nil
+}